@@ -0,0 +1,36 @@
+package main
+
+import (
+    "flag"
+    "log"
+    "net/http"
+    "time"
+
+    "github.com/herrjemand/gethGoKitRPCMicroService/fakegeth"
+)
+
+var listenAddress = flag.String("listen", "127.0.0.1:8545", "address to serve JSON-RPC on, HTTP and WebSocket")
+var blocks = flag.Int("blocks", fakegeth.DefaultChainConfig.Blocks, "blocks generated on start")
+var txsPerBlock = flag.Int("txs", fakegeth.DefaultChainConfig.TxsPerBlock, "transactions per block")
+var blockTime = flag.Duration("block-time", 0, "mine a new block every interval, 0 to keep the chain still")
+
+func main() {
+    flag.Parse()
+
+    config := fakegeth.DefaultChainConfig
+    config.Blocks = *blocks
+    config.TxsPerBlock = *txsPerBlock
+    chain := fakegeth.NewChain(config)
+
+    if *blockTime > 0 {
+        go func() {
+            for range time.Tick(*blockTime) {
+                chain.Mine(1)
+                log.Println("Mined block " + chain.Head().Number)
+            }
+        }()
+    }
+
+    log.Println("Starting fake geth at " + *listenAddress + ", head " + chain.Head().Number + "...")
+    log.Fatal(http.ListenAndServe(*listenAddress, fakegeth.NewServer(chain)))
+}
\ No newline at end of file
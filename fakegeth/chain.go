@@ -0,0 +1,579 @@
+package fakegeth
+
+import (
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "math/big"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+
+    "golang.org/x/crypto/sha3"
+)
+
+const TransferEventTopic string = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+const zeroHash string = "0x0000000000000000000000000000000000000000000000000000000000000000"
+const emptyUnclesHash string = "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"
+const blockGasLimit uint64 = 30000000
+const transferGas uint64 = 21000
+const tokenTransferGas uint64 = 52000
+const gwei uint64 = 1000000000
+
+// genesisBalance is the balance of every account at genesis, 1000 ether.
+var genesisBalance, _ = new(big.Int).SetString("1000000000000000000000", 10)
+
+// ChainConfig describes the generated chain. Zero values get the defaults
+// of DefaultChainConfig.
+type ChainConfig struct {
+    // Seed makes hashes and addresses differ between chains; the same config
+    // always generates the same chain.
+    Seed string
+    ChainId uint64
+    Blocks int
+    TxsPerBlock int
+    // LogsPerTx ERC-20 Transfer logs are emitted by every transaction after
+    // the first one of each block, which stays a plain value transfer.
+    LogsPerTx int
+    Accounts int
+    GenesisTime time.Time
+    BlockTime time.Duration
+}
+
+var DefaultChainConfig = ChainConfig{
+    Seed: "fakegeth",
+    ChainId: 1337,
+    Blocks: 16,
+    TxsPerBlock: 4,
+    LogsPerTx: 1,
+    Accounts: 8,
+    GenesisTime: time.Unix(1546300800, 0),
+    BlockTime: 12 * time.Second,
+}
+
+// SyncState is reported by eth_syncing while set.
+type SyncState struct {
+    StartingBlock string `json:"startingBlock"`
+    CurrentBlock string `json:"currentBlock"`
+    HighestBlock string `json:"highestBlock"`
+}
+
+type Block struct {
+    Number string `json:"number"`
+    Hash string `json:"hash"`
+    ParentHash string `json:"parentHash"`
+    Nonce string `json:"nonce"`
+    Sha3Uncles string `json:"sha3Uncles"`
+    LogsBloom string `json:"logsBloom"`
+    TransactionsRoot string `json:"transactionsRoot"`
+    StateRoot string `json:"stateRoot"`
+    ReceiptsRoot string `json:"receiptsRoot"`
+    Miner string `json:"miner"`
+    Difficulty string `json:"difficulty"`
+    TotalDifficulty string `json:"totalDifficulty"`
+    ExtraData string `json:"extraData"`
+    Size string `json:"size"`
+    GasLimit string `json:"gasLimit"`
+    GasUsed string `json:"gasUsed"`
+    Timestamp string `json:"timestamp"`
+    BaseFeePerGas string `json:"baseFeePerGas"`
+    MixHash string `json:"mixHash"`
+    Transactions []*Transaction `json:"-"`
+    Uncles []string `json:"uncles"`
+}
+
+type Transaction struct {
+    BlockHash string `json:"blockHash"`
+    BlockNumber string `json:"blockNumber"`
+    From string `json:"from"`
+    Gas string `json:"gas"`
+    GasPrice string `json:"gasPrice"`
+    MaxFeePerGas string `json:"maxFeePerGas"`
+    MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas"`
+    Hash string `json:"hash"`
+    Input string `json:"input"`
+    Nonce string `json:"nonce"`
+    To string `json:"to"`
+    TransactionIndex string `json:"transactionIndex"`
+    Value string `json:"value"`
+    Type string `json:"type"`
+    ChainId string `json:"chainId"`
+    V string `json:"v"`
+    R string `json:"r"`
+    S string `json:"s"`
+}
+
+type Receipt struct {
+    TransactionHash string `json:"transactionHash"`
+    TransactionIndex string `json:"transactionIndex"`
+    BlockHash string `json:"blockHash"`
+    BlockNumber string `json:"blockNumber"`
+    From string `json:"from"`
+    To string `json:"to"`
+    CumulativeGasUsed string `json:"cumulativeGasUsed"`
+    GasUsed string `json:"gasUsed"`
+    EffectiveGasPrice string `json:"effectiveGasPrice"`
+    ContractAddress *string `json:"contractAddress"`
+    Logs []*Log `json:"logs"`
+    LogsBloom string `json:"logsBloom"`
+    Status string `json:"status"`
+    Type string `json:"type"`
+}
+
+type Log struct {
+    Address string `json:"address"`
+    Topics []string `json:"topics"`
+    Data string `json:"data"`
+    BlockNumber string `json:"blockNumber"`
+    BlockHash string `json:"blockHash"`
+    TransactionHash string `json:"transactionHash"`
+    TransactionIndex string `json:"transactionIndex"`
+    LogIndex string `json:"logIndex"`
+    Removed bool `json:"removed"`
+}
+
+// accountState is the balance, token balance and nonce of an account after
+// a block.
+type accountState struct {
+    balance *big.Int
+    tokenBalance *big.Int
+    nonce uint64
+}
+
+/* ----- CHAIN ----- */
+
+// Chain is a deterministic in-memory chain. Every block carries
+// TxsPerBlock transactions between a fixed set of accounts, with receipts
+// and ERC-20 Transfer logs from a single token contract.
+type Chain struct {
+    config ChainConfig
+
+    mu sync.RWMutex
+    blocks []*Block
+    blocksByHash map[string]*Block
+    txsByHash map[string]*Transaction
+    receipts map[string]*Receipt
+    accounts map[string]*accountState
+    // states holds a copy of accounts after every block.
+    states []map[string]accountState
+    syncing *SyncState
+    // sent holds the raw transactions of eth_sendRawTransaction by hash.
+    sent map[string]string
+    headListeners []func(*Block)
+}
+
+func NewChain(config ChainConfig) *Chain {
+    if config.Seed == "" {
+        config.Seed = DefaultChainConfig.Seed
+    }
+    if config.ChainId == 0 {
+        config.ChainId = DefaultChainConfig.ChainId
+    }
+    if config.Accounts < 2 {
+        config.Accounts = DefaultChainConfig.Accounts
+    }
+    if config.GenesisTime.IsZero() {
+        config.GenesisTime = DefaultChainConfig.GenesisTime
+    }
+    if config.BlockTime == 0 {
+        config.BlockTime = DefaultChainConfig.BlockTime
+    }
+
+    chain := &Chain{
+        config: config,
+        blocksByHash: map[string]*Block{},
+        txsByHash: map[string]*Transaction{},
+        receipts: map[string]*Receipt{},
+        accounts: map[string]*accountState{},
+        sent: map[string]string{},
+    }
+
+    for i := 0; i < config.Accounts; i++ {
+        chain.accounts[chain.Account(i)] = &accountState{
+            balance: new(big.Int).Set(genesisBalance),
+            tokenBalance: new(big.Int).Set(genesisTokenBalance),
+        }
+    }
+
+    chain.mine(config.Blocks + 1)
+    return chain
+}
+
+func (c *Chain) ChainId() uint64 {
+    return c.config.ChainId
+}
+
+// Account returns the i-th generated account address.
+func (c *Chain) Account(i int) string {
+    return c.address("account", i % c.config.Accounts)
+}
+
+// TokenAddress is the contract emitting every generated Transfer log.
+func (c *Chain) TokenAddress() string {
+    return c.address("token", 0)
+}
+
+func (c *Chain) Head() *Block {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    return c.blocks[len(c.blocks) - 1]
+}
+
+func (c *Chain) BlockByNumber(number uint64) (*Block, bool) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    if number >= uint64(len(c.blocks)) {
+        return nil, false
+    }
+    return c.blocks[number], true
+}
+
+func (c *Chain) BlockByHash(hash string) (*Block, bool) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    block, ok := c.blocksByHash[strings.ToLower(hash)]
+    return block, ok
+}
+
+func (c *Chain) TransactionByHash(hash string) (*Transaction, bool) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    tx, ok := c.txsByHash[strings.ToLower(hash)]
+    return tx, ok
+}
+
+func (c *Chain) ReceiptByHash(hash string) (*Receipt, bool) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    receipt, ok := c.receipts[strings.ToLower(hash)]
+    return receipt, ok
+}
+
+// Logs returns the logs of blocks from..to, inclusive.
+func (c *Chain) Logs(from uint64, to uint64) []*Log {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    logs := []*Log{}
+    for number := from; number <= to && number < uint64(len(c.blocks)); number++ {
+        for _, tx := range c.blocks[number].Transactions {
+            logs = append(logs, c.receipts[tx.Hash].Logs...)
+        }
+    }
+    return logs
+}
+
+// BalanceAt returns the balance of address after block number.
+func (c *Chain) BalanceAt(address string, number uint64) *big.Int {
+    state := c.stateAt(address, number)
+    if state.balance == nil {
+        return new(big.Int)
+    }
+    return new(big.Int).Set(state.balance)
+}
+
+// NonceAt returns the transaction count of address after block number.
+func (c *Chain) NonceAt(address string, number uint64) uint64 {
+    return c.stateAt(address, number).nonce
+}
+
+// CodeAt returns the code of address, which is only set for the token
+// contract.
+func (c *Chain) CodeAt(address string) string {
+    if !strings.EqualFold(address, c.TokenAddress()) {
+        return "0x"
+    }
+    return "0x" + strings.Repeat("60806040", 16)
+}
+
+// StorageAt returns the 32 byte storage slot of address. Only the token
+// contract has non-zero storage, derived from the slot.
+func (c *Chain) StorageAt(address string, slot string) string {
+    if !strings.EqualFold(address, c.TokenAddress()) {
+        return zeroHash
+    }
+    return c.hash("storage", strings.ToLower(slot))
+}
+
+func (c *Chain) stateAt(address string, number uint64) accountState {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    if number >= uint64(len(c.states)) {
+        number = uint64(len(c.states) - 1)
+    }
+    return c.states[number][strings.ToLower(address)]
+}
+
+// Syncing returns the sync state reported by eth_syncing, nil once synced.
+func (c *Chain) Syncing() *SyncState {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    return c.syncing
+}
+
+// SetSyncing makes eth_syncing report progress towards highestBlock, or
+// report a synced node when highestBlock is not above the head.
+func (c *Chain) SetSyncing(highestBlock uint64) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    head := uint64(len(c.blocks) - 1)
+    if highestBlock <= head {
+        c.syncing = nil
+        return
+    }
+
+    c.syncing = &SyncState{
+        StartingBlock: hexUint(0),
+        CurrentBlock: hexUint(head),
+        HighestBlock: hexUint(highestBlock),
+    }
+}
+
+// SendRawTransaction takes in a signed transaction and returns its hash,
+// false when it was already sent. Sent transactions are never mined.
+func (c *Chain) SendRawTransaction(raw string) (string, bool) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    data, _ := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+    hash := sha3.NewLegacyKeccak256()
+    hash.Write(data)
+    txHash := "0x" + hex.EncodeToString(hash.Sum(nil))
+
+    if _, ok := c.sent[txHash]; ok {
+        return txHash, false
+    }
+    c.sent[txHash] = raw
+    return txHash, true
+}
+
+// SentTransaction returns the raw transaction sent under hash.
+func (c *Chain) SentTransaction(hash string) (string, bool) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    raw, ok := c.sent[strings.ToLower(hash)]
+    return raw, ok
+}
+
+// Mine appends n blocks and notifies head listeners of each of them.
+func (c *Chain) Mine(n int) {
+    c.mu.Lock()
+    mined := c.mine(n)
+    listeners := append([]func(*Block){}, c.headListeners...)
+    c.mu.Unlock()
+
+    for _, block := range mined {
+        for _, listener := range listeners {
+            listener(block)
+        }
+    }
+}
+
+// OnNewHead registers listener for every block mined from now on.
+func (c *Chain) OnNewHead(listener func(*Block)) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    c.headListeners = append(c.headListeners, listener)
+}
+
+// mine generates n blocks on top of the head. The caller holds c.mu.
+func (c *Chain) mine(n int) []*Block {
+    mined := []*Block{}
+    for i := 0; i < n; i++ {
+        number := uint64(len(c.blocks))
+        parentHash := zeroHash
+        if number > 0 {
+            parentHash = c.blocks[number - 1].Hash
+        }
+
+        block := c.generateBlock(number, parentHash)
+        c.blocks = append(c.blocks, block)
+        c.blocksByHash[block.Hash] = block
+        c.states = append(c.states, c.snapshotState())
+        mined = append(mined, block)
+    }
+    return mined
+}
+
+func (c *Chain) generateBlock(number uint64, parentHash string) *Block {
+    baseFee := baseFeeAt(number)
+    hash := c.hash("block", number)
+
+    block := &Block{
+        Number: hexUint(number),
+        Hash: hash,
+        ParentHash: parentHash,
+        Nonce: "0x0000000000000000",
+        Sha3Uncles: emptyUnclesHash,
+        LogsBloom: "0x" + strings.Repeat("00", 256),
+        TransactionsRoot: c.hash("transactionsRoot", number),
+        StateRoot: c.hash("stateRoot", number),
+        ReceiptsRoot: c.hash("receiptsRoot", number),
+        Miner: c.address("miner", 0),
+        Difficulty: "0x0",
+        TotalDifficulty: "0x0",
+        ExtraData: "0x",
+        GasLimit: hexUint(blockGasLimit),
+        Timestamp: hexUint(uint64(c.config.GenesisTime.Add(time.Duration(number) * c.config.BlockTime).Unix())),
+        BaseFeePerGas: hexUint(baseFee),
+        MixHash: c.hash("mixHash", number),
+        Transactions: []*Transaction{},
+        Uncles: []string{},
+    }
+
+    txCount := c.config.TxsPerBlock
+    if number == 0 {
+        txCount = 0
+    }
+
+    var cumulativeGas uint64
+    var logIndex uint64
+    for i := 0; i < txCount; i++ {
+        tx, receipt, gasUsed := c.generateTransaction(block, baseFee, uint64(i), cumulativeGas, logIndex)
+        cumulativeGas += gasUsed
+        logIndex += uint64(len(receipt.Logs))
+
+        block.Transactions = append(block.Transactions, tx)
+        c.txsByHash[tx.Hash] = tx
+        c.receipts[tx.Hash] = receipt
+    }
+
+    block.GasUsed = hexUint(cumulativeGas)
+    block.Size = hexUint(uint64(540 + 110 * txCount))
+    return block
+}
+
+func (c *Chain) generateTransaction(block *Block, baseFee uint64, index uint64, cumulativeGas uint64, logIndex uint64) (*Transaction, *Receipt, uint64) {
+    number, _ := strconv.ParseUint(block.Number[2:], 16, 64)
+    seq := int(number) * c.config.TxsPerBlock + int(index)
+    from := c.Account(seq)
+    to := c.Account(seq + 1)
+    // Priority fees rise with the position in the block, so fee history
+    // percentiles differ.
+    tip := gwei * (index + 1)
+    value := uint64(seq + 1) * gwei
+    hash := c.hash("tx", number, index)
+
+    nonce := c.account(from).nonce
+    c.account(from).nonce = nonce + 1
+
+    tx := &Transaction{
+        BlockHash: block.Hash,
+        BlockNumber: block.Number,
+        From: from,
+        Gas: hexUint(tokenTransferGas),
+        GasPrice: hexUint(baseFee + tip),
+        MaxFeePerGas: hexUint(2 * baseFee + tip),
+        MaxPriorityFeePerGas: hexUint(tip),
+        Hash: hash,
+        Input: "0x",
+        Nonce: hexUint(nonce),
+        To: to,
+        TransactionIndex: hexUint(index),
+        Value: hexUint(value),
+        Type: "0x2",
+        ChainId: hexUint(c.config.ChainId),
+        V: "0x0",
+        R: c.hash("r", number, index),
+        S: c.hash("s", number, index),
+    }
+
+    gasUsed := transferGas
+    logs := []*Log{}
+    if index > 0 && c.config.LogsPerTx > 0 {
+        // A token transfer: transfer(to, value) on the token contract.
+        gasUsed = tokenTransferGas
+        tx.To = c.TokenAddress()
+        tx.Value = "0x0"
+        tx.Input = "0xa9059cbb" + pad32(to[2:]) + pad32(strconv.FormatUint(value, 16))
+        c.account(from).tokenBalance.Sub(c.account(from).tokenBalance, new(big.Int).SetUint64(value))
+        c.account(to).tokenBalance.Add(c.account(to).tokenBalance, new(big.Int).SetUint64(value))
+
+        for i := 0; i < c.config.LogsPerTx; i++ {
+            logs = append(logs, &Log{
+                Address: c.TokenAddress(),
+                Topics: []string{TransferEventTopic, "0x" + pad32(from[2:]), "0x" + pad32(to[2:])},
+                Data: "0x" + pad32(strconv.FormatUint(value, 16)),
+                BlockNumber: block.Number,
+                BlockHash: block.Hash,
+                TransactionHash: hash,
+                TransactionIndex: tx.TransactionIndex,
+                LogIndex: hexUint(logIndex + uint64(i)),
+            })
+        }
+    }
+
+    fee := new(big.Int).SetUint64(gasUsed * (baseFee + tip))
+    sent := new(big.Int).SetUint64(value)
+    if tx.Value == "0x0" {
+        sent.SetUint64(0)
+    }
+    c.account(from).balance.Sub(c.account(from).balance, fee.Add(fee, sent))
+    c.account(tx.To).balance.Add(c.account(tx.To).balance, sent)
+    c.account(block.Miner).balance.Add(c.account(block.Miner).balance, new(big.Int).SetUint64(gasUsed * tip))
+
+    receipt := &Receipt{
+        TransactionHash: hash,
+        TransactionIndex: tx.TransactionIndex,
+        BlockHash: block.Hash,
+        BlockNumber: block.Number,
+        From: from,
+        To: tx.To,
+        CumulativeGasUsed: hexUint(cumulativeGas + gasUsed),
+        GasUsed: hexUint(gasUsed),
+        EffectiveGasPrice: tx.GasPrice,
+        Logs: logs,
+        LogsBloom: block.LogsBloom,
+        Status: "0x1",
+        Type: tx.Type,
+    }
+
+    return tx, receipt, gasUsed
+}
+
+// account returns the mutable state of address. The caller holds c.mu.
+func (c *Chain) account(address string) *accountState {
+    state, ok := c.accounts[address]
+    if !ok {
+        state = &accountState{balance: new(big.Int), tokenBalance: new(big.Int)}
+        c.accounts[address] = state
+    }
+    return state
+}
+
+func (c *Chain) snapshotState() map[string]accountState {
+    snapshot := make(map[string]accountState, len(c.accounts))
+    for address, state := range c.accounts {
+        snapshot[address] = accountState{new(big.Int).Set(state.balance), new(big.Int).Set(state.tokenBalance), state.nonce}
+    }
+    return snapshot
+}
+
+func (c *Chain) hash(parts ...interface{}) string {
+    sum := sha256.Sum256([]byte(c.config.Seed + fmt.Sprint(parts...)))
+    return "0x" + hex.EncodeToString(sum[:])
+}
+
+func (c *Chain) address(parts ...interface{}) string {
+    return c.hash(parts...)[:42]
+}
+
+func hexUint(n uint64) string {
+    return "0x" + strconv.FormatUint(n, 16)
+}
+
+func pad32(hexValue string) string {
+    return strings.Repeat("0", 64 - len(hexValue)) + hexValue
+}
\ No newline at end of file
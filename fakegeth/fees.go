@@ -0,0 +1,101 @@
+package fakegeth
+
+import (
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// FeeHistory is the result of eth_feeHistory. BaseFeePerGas has one more
+// entry than the blocks, the base fee of the block after the newest one.
+type FeeHistory struct {
+    OldestBlock string `json:"oldestBlock"`
+    BaseFeePerGas []string `json:"baseFeePerGas"`
+    GasUsedRatio []float64 `json:"gasUsedRatio"`
+    Reward [][]string `json:"reward,omitempty"`
+}
+
+// baseFeeAt is the base fee of block number, which rises by 1000 wei a
+// block.
+func baseFeeAt(number uint64) uint64 {
+    return gwei + number * 1000
+}
+
+// FeeHistory returns the fees of count blocks up to newest. For every
+// block, the reward at a percentile is the priority fee of the transaction
+// reached when summing gas used in order of priority fee, as geth does it.
+func (c *Chain) FeeHistory(count uint64, newest uint64, percentiles []float64) FeeHistory {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    if newest >= uint64(len(c.blocks)) {
+        newest = uint64(len(c.blocks) - 1)
+    }
+    if count > newest + 1 {
+        count = newest + 1
+    }
+    oldest := newest + 1 - count
+
+    history := FeeHistory{OldestBlock: hexUint(oldest), BaseFeePerGas: []string{}, GasUsedRatio: []float64{}}
+    for number := oldest; number <= newest; number++ {
+        block := c.blocks[number]
+        gasUsed, _ := strconv.ParseUint(block.GasUsed[2:], 16, 64)
+
+        history.BaseFeePerGas = append(history.BaseFeePerGas, block.BaseFeePerGas)
+        history.GasUsedRatio = append(history.GasUsedRatio, float64(gasUsed) / float64(blockGasLimit))
+        if len(percentiles) > 0 {
+            history.Reward = append(history.Reward, c.blockRewards(block, gasUsed, percentiles))
+        }
+    }
+    history.BaseFeePerGas = append(history.BaseFeePerGas, hexUint(baseFeeAt(newest + 1)))
+
+    return history
+}
+
+// blockRewards returns the priority fees paid at percentiles of the gas
+// used in block. The caller holds c.mu.
+func (c *Chain) blockRewards(block *Block, gasUsed uint64, percentiles []float64) []string {
+    rewards := make([]string, len(percentiles))
+    if len(block.Transactions) == 0 {
+        for i := range rewards {
+            rewards[i] = "0x0"
+        }
+        return rewards
+    }
+
+    type txFee struct {
+        tip uint64
+        gasUsed uint64
+    }
+
+    fees := []txFee{}
+    for _, tx := range block.Transactions {
+        tip, _ := strconv.ParseUint(tx.MaxPriorityFeePerGas[2:], 16, 64)
+        used, _ := strconv.ParseUint(c.receipts[tx.Hash].GasUsed[2:], 16, 64)
+        fees = append(fees, txFee{tip, used})
+    }
+    sort.Slice(fees, func(i, j int) bool {
+        return fees[i].tip < fees[j].tip
+    })
+
+    index := 0
+    sum := fees[0].gasUsed
+    for i, percentile := range percentiles {
+        threshold := uint64(float64(gasUsed) * percentile / 100)
+        for sum < threshold && index < len(fees) - 1 {
+            index++
+            sum += fees[index].gasUsed
+        }
+        rewards[i] = hexUint(fees[index].tip)
+    }
+    return rewards
+}
+
+// EstimateGas returns the gas a call of data on to needs: a token call on
+// the token contract, a plain transfer otherwise.
+func (c *Chain) EstimateGas(to string, data string) uint64 {
+    if len(data) > 2 && strings.EqualFold(to, c.TokenAddress()) {
+        return tokenTransferGas
+    }
+    return transferGas
+}
\ No newline at end of file
@@ -0,0 +1,605 @@
+package fakegeth
+
+import (
+    "encoding/hex"
+    "encoding/json"
+    "strconv"
+    "strings"
+)
+
+// Blocks behind the head reported for the "safe" and "finalized" tags.
+const safeDepth uint64 = 32
+const finalizedDepth uint64 = 64
+
+type method func(s *Server, params []json.RawMessage) (interface{}, *Error)
+
+type blockResponse struct {
+    *Block
+    Transactions interface{} `json:"transactions"`
+}
+
+// blockSelector is the EIP-1898 block parameter of state queries.
+type blockSelector struct {
+    BlockHash string `json:"blockHash"`
+    BlockNumber string `json:"blockNumber"`
+}
+
+type callArgs struct {
+    From string `json:"from"`
+    To string `json:"to"`
+    Data string `json:"data"`
+    Input string `json:"input"`
+}
+
+type traceConfig struct {
+    Tracer string `json:"tracer"`
+}
+
+type logFilter struct {
+    FromBlock string `json:"fromBlock"`
+    ToBlock string `json:"toBlock"`
+    BlockHash string `json:"blockHash"`
+    Address json.RawMessage `json:"address"`
+    Topics []json.RawMessage `json:"topics"`
+}
+
+var methods map[string]method
+
+func init() {
+    methods = map[string]method{
+        "web3_clientVersion": web3ClientVersion,
+        "net_version": netVersion,
+        "eth_chainId": ethChainId,
+        "eth_blockNumber": ethBlockNumber,
+        "eth_syncing": ethSyncing,
+        "eth_gasPrice": ethGasPrice,
+        "eth_getBlockByHash": ethGetBlockByHash,
+        "eth_getBlockByNumber": ethGetBlockByNumber,
+        "eth_getBlockTransactionCountByHash": ethGetBlockTransactionCountByHash,
+        "eth_getBlockTransactionCountByNumber": ethGetBlockTransactionCountByNumber,
+        "eth_getTransactionByHash": ethGetTransactionByHash,
+        "eth_getTransactionByBlockHashAndIndex": ethGetTransactionByBlockHashAndIndex,
+        "eth_getTransactionByBlockNumberAndIndex": ethGetTransactionByBlockNumberAndIndex,
+        "eth_getTransactionReceipt": ethGetTransactionReceipt,
+        "eth_getLogs": ethGetLogs,
+        "eth_getBalance": ethGetBalance,
+        "eth_getTransactionCount": ethGetTransactionCount,
+        "eth_getCode": ethGetCode,
+        "eth_getStorageAt": ethGetStorageAt,
+        "eth_call": ethCall,
+        "eth_sendRawTransaction": ethSendRawTransaction,
+        "eth_feeHistory": ethFeeHistory,
+        "eth_maxPriorityFeePerGas": ethMaxPriorityFeePerGas,
+        "eth_estimateGas": ethEstimateGas,
+        "debug_traceTransaction": debugTraceTransaction,
+    }
+}
+
+func web3ClientVersion(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    return clientVersion, nil
+}
+
+func netVersion(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    return strconv.FormatUint(s.Chain.ChainId(), 10), nil
+}
+
+func ethChainId(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    return hexUint(s.Chain.ChainId()), nil
+}
+
+func ethBlockNumber(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    return s.Chain.Head().Number, nil
+}
+
+func ethSyncing(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    if syncing := s.Chain.Syncing(); syncing != nil {
+        return syncing, nil
+    }
+    return false, nil
+}
+
+func ethGasPrice(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    baseFee, _ := strconv.ParseUint(s.Chain.Head().BaseFeePerGas[2:], 16, 64)
+    return hexUint(baseFee + gwei), nil
+}
+
+func ethGetBlockByHash(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    block, rpcErr := blockByHashParam(s, params, 0)
+    if block == nil {
+        return nil, rpcErr
+    }
+
+    full, rpcErr := boolParam(params, 1)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    return formatBlock(block, full), nil
+}
+
+func ethGetBlockByNumber(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    block, rpcErr := blockByNumberParam(s, params, 0)
+    if block == nil {
+        return nil, rpcErr
+    }
+
+    full, rpcErr := boolParam(params, 1)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    return formatBlock(block, full), nil
+}
+
+func ethGetBlockTransactionCountByHash(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    block, rpcErr := blockByHashParam(s, params, 0)
+    if block == nil {
+        return nil, rpcErr
+    }
+    return hexUint(uint64(len(block.Transactions))), nil
+}
+
+func ethGetBlockTransactionCountByNumber(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    block, rpcErr := blockByNumberParam(s, params, 0)
+    if block == nil {
+        return nil, rpcErr
+    }
+    return hexUint(uint64(len(block.Transactions))), nil
+}
+
+func ethGetTransactionByHash(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    hash, rpcErr := hashParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    if tx, ok := s.Chain.TransactionByHash(hash); ok {
+        return tx, nil
+    }
+    return nil, nil
+}
+
+func ethGetTransactionByBlockHashAndIndex(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    block, rpcErr := blockByHashParam(s, params, 0)
+    if block == nil {
+        return nil, rpcErr
+    }
+    return transactionAt(block, params, 1)
+}
+
+func ethGetTransactionByBlockNumberAndIndex(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    block, rpcErr := blockByNumberParam(s, params, 0)
+    if block == nil {
+        return nil, rpcErr
+    }
+    return transactionAt(block, params, 1)
+}
+
+func ethGetTransactionReceipt(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    hash, rpcErr := hashParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    if receipt, ok := s.Chain.ReceiptByHash(hash); ok {
+        return receipt, nil
+    }
+    return nil, nil
+}
+
+func ethGetLogs(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    var filter logFilter
+    if len(params) < 1 || json.Unmarshal(params[0], &filter) != nil {
+        return nil, &Error{Code: -32602, Message: "invalid argument 0: expected filter object"}
+    }
+
+    var from, to uint64
+    if filter.BlockHash != "" {
+        block, ok := s.Chain.BlockByHash(filter.BlockHash)
+        if !ok {
+            return nil, &Error{Code: -32000, Message: "unknown block"}
+        }
+        from, _ = strconv.ParseUint(block.Number[2:], 16, 64)
+        to = from
+    } else {
+        var rpcErr *Error
+        from, rpcErr = resolveBlockNumber(s, filter.FromBlock)
+        if rpcErr != nil {
+            return nil, rpcErr
+        }
+        to, rpcErr = resolveBlockNumber(s, filter.ToBlock)
+        if rpcErr != nil {
+            return nil, rpcErr
+        }
+    }
+
+    if s.MaxLogsRange > 0 && to >= from && to - from + 1 > s.MaxLogsRange {
+        return nil, &Error{Code: -32005, Message: "exceed maximum block range: " + strconv.FormatUint(s.MaxLogsRange, 10)}
+    }
+
+    addresses := []string{}
+    if len(filter.Address) > 0 && string(filter.Address) != "null" {
+        var address string
+        if json.Unmarshal(filter.Address, &address) == nil {
+            addresses = append(addresses, address)
+        } else if json.Unmarshal(filter.Address, &addresses) != nil {
+            return nil, &Error{Code: -32602, Message: "invalid address filter"}
+        }
+    }
+
+    topics := make([][]string, len(filter.Topics))
+    for i, topic := range filter.Topics {
+        var single string
+        if string(topic) == "null" {
+            continue
+        } else if json.Unmarshal(topic, &single) == nil {
+            topics[i] = []string{single}
+        } else if json.Unmarshal(topic, &topics[i]) != nil {
+            return nil, &Error{Code: -32602, Message: "invalid topic filter"}
+        }
+    }
+
+    logs := []*Log{}
+    for _, log := range s.Chain.Logs(from, to) {
+        if matchLog(log, addresses, topics) {
+            logs = append(logs, log)
+        }
+    }
+
+    if s.MaxLogs > 0 && len(logs) > s.MaxLogs {
+        return nil, &Error{Code: -32005, Message: "query returned more than " + strconv.Itoa(s.MaxLogs) + " results"}
+    }
+    return logs, nil
+}
+
+func ethGetBalance(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    address, rpcErr := addressParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    number, rpcErr := stateBlockParam(s, params, 1)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    return "0x" + s.Chain.BalanceAt(address, number).Text(16), nil
+}
+
+func ethGetTransactionCount(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    address, rpcErr := addressParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    number, rpcErr := stateBlockParam(s, params, 1)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    return hexUint(s.Chain.NonceAt(address, number)), nil
+}
+
+func ethGetCode(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    address, rpcErr := addressParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    _, rpcErr = stateBlockParam(s, params, 1)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    return s.Chain.CodeAt(address), nil
+}
+
+func ethGetStorageAt(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    address, rpcErr := addressParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    slot, rpcErr := stringParam(params, 1)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    _, rpcErr = stateBlockParam(s, params, 2)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    return s.Chain.StorageAt(address, slot), nil
+}
+
+func ethCall(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    var call callArgs
+    if len(params) < 1 || json.Unmarshal(params[0], &call) != nil {
+        return nil, &Error{Code: -32602, Message: "invalid argument 0: expected call object"}
+    }
+
+    number, _ := strconv.ParseUint(s.Chain.Head().Number[2:], 16, 64)
+    if len(params) > 1 {
+        var rpcErr *Error
+        number, rpcErr = stateBlockParam(s, params, 1)
+        if rpcErr != nil {
+            return nil, rpcErr
+        }
+    }
+
+    data := call.Input
+    if data == "" {
+        data = call.Data
+    }
+
+    result, ok := s.Chain.Call(call.To, data, number)
+    if !ok {
+        return nil, &Error{Code: 3, Message: "execution reverted", Data: "0x"}
+    }
+    return result, nil
+}
+
+func ethSendRawTransaction(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    var raw string
+    if len(params) < 1 || json.Unmarshal(params[0], &raw) != nil || !strings.HasPrefix(raw, "0x") || len(raw) % 2 != 0 {
+        return nil, &Error{Code: -32602, Message: "invalid argument 0: expected raw transaction hex"}
+    }
+    if _, err := hex.DecodeString(raw[2:]); err != nil || len(raw) == 2 {
+        return nil, &Error{Code: -32602, Message: "invalid argument 0: expected raw transaction hex"}
+    }
+
+    hash, ok := s.Chain.SendRawTransaction(raw)
+    if !ok {
+        return nil, &Error{Code: -32000, Message: "already known"}
+    }
+    return hash, nil
+}
+
+// maxFeeHistoryBlocks is the most blocks eth_feeHistory answers for, as in
+// geth.
+const maxFeeHistoryBlocks uint64 = 1024
+
+func ethFeeHistory(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    countHex, rpcErr := stringParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    count, err := strconv.ParseUint(strings.TrimPrefix(countHex, "0x"), 16, 64)
+    if err != nil || count == 0 {
+        return nil, &Error{Code: -32602, Message: "invalid argument 0: block count must be a positive hex number"}
+    }
+    if count > maxFeeHistoryBlocks {
+        count = maxFeeHistoryBlocks
+    }
+
+    tag, rpcErr := stringParam(params, 1)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    newest, rpcErr := resolveBlockNumber(s, tag)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    var percentiles []float64
+    if len(params) > 2 && json.Unmarshal(params[2], &percentiles) != nil {
+        return nil, &Error{Code: -32602, Message: "invalid argument 2: expected list of percentiles"}
+    }
+    for i, percentile := range percentiles {
+        if percentile < 0 || percentile > 100 || i > 0 && percentile < percentiles[i - 1] {
+            return nil, &Error{Code: -32602, Message: "invalid reward percentile: " + strconv.FormatFloat(percentile, 'f', -1, 64)}
+        }
+    }
+
+    return s.Chain.FeeHistory(count, newest, percentiles), nil
+}
+
+func ethMaxPriorityFeePerGas(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    return hexUint(gwei), nil
+}
+
+func ethEstimateGas(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    var call callArgs
+    if len(params) < 1 || json.Unmarshal(params[0], &call) != nil {
+        return nil, &Error{Code: -32602, Message: "invalid argument 0: expected call object"}
+    }
+
+    data := call.Input
+    if data == "" {
+        data = call.Data
+    }
+    return hexUint(s.Chain.EstimateGas(call.To, data)), nil
+}
+
+// debugTraceTransaction supports the callTracer and prestateTracer, not
+// the default struct logger.
+func debugTraceTransaction(s *Server, params []json.RawMessage) (interface{}, *Error) {
+    hash, rpcErr := hashParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    var config traceConfig
+    if len(params) > 1 && json.Unmarshal(params[1], &config) != nil {
+        return nil, &Error{Code: -32602, Message: "invalid argument 1: expected trace config"}
+    }
+
+    var result interface{}
+    var ok bool
+    switch config.Tracer {
+    case "callTracer":
+        result, ok = s.Chain.TraceCall(hash)
+    case "prestateTracer":
+        result, ok = s.Chain.TracePrestate(hash)
+    default:
+        return nil, &Error{Code: -32000, Message: "fakegeth only supports callTracer and prestateTracer"}
+    }
+
+    if !ok {
+        return nil, &Error{Code: -32000, Message: "transaction not found"}
+    }
+    return result, nil
+}
+
+func matchLog(log *Log, addresses []string, topics [][]string) bool {
+    if len(addresses) > 0 && !containsFold(addresses, log.Address) {
+        return false
+    }
+
+    for i, alternatives := range topics {
+        if len(alternatives) == 0 {
+            continue
+        }
+        if i >= len(log.Topics) || !containsFold(alternatives, log.Topics[i]) {
+            return false
+        }
+    }
+    return true
+}
+
+func containsFold(values []string, value string) bool {
+    for _, v := range values {
+        if strings.EqualFold(v, value) {
+            return true
+        }
+    }
+    return false
+}
+
+func formatBlock(block *Block, full bool) blockResponse {
+    if full {
+        return blockResponse{block, block.Transactions}
+    }
+
+    hashes := make([]string, len(block.Transactions))
+    for i, tx := range block.Transactions {
+        hashes[i] = tx.Hash
+    }
+    return blockResponse{block, hashes}
+}
+
+func transactionAt(block *Block, params []json.RawMessage, i int) (interface{}, *Error) {
+    indexHex, rpcErr := stringParam(params, i)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    index, err := strconv.ParseUint(strings.TrimPrefix(indexHex, "0x"), 16, 64)
+    if err != nil {
+        return nil, &Error{Code: -32602, Message: "invalid argument " + strconv.Itoa(i) + ": hex string without 0x prefix"}
+    }
+
+    if index >= uint64(len(block.Transactions)) {
+        return nil, nil
+    }
+    return block.Transactions[index], nil
+}
+
+// blockByHashParam returns the block, or nil with a nil error when it is
+// unknown, which the node answers with a null result.
+func blockByHashParam(s *Server, params []json.RawMessage, i int) (*Block, *Error) {
+    hash, rpcErr := hashParam(params, i)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    block, _ := s.Chain.BlockByHash(hash)
+    return block, nil
+}
+
+func blockByNumberParam(s *Server, params []json.RawMessage, i int) (*Block, *Error) {
+    tag, rpcErr := stringParam(params, i)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    number, rpcErr := resolveBlockNumber(s, tag)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    block, _ := s.Chain.BlockByNumber(number)
+    return block, nil
+}
+
+func resolveBlockNumber(s *Server, tag string) (uint64, *Error) {
+    head, _ := strconv.ParseUint(s.Chain.Head().Number[2:], 16, 64)
+
+    switch tag {
+    case "", "latest", "pending":
+        return head, nil
+    case "earliest":
+        return 0, nil
+    case "safe":
+        return subClamped(head, safeDepth), nil
+    case "finalized":
+        return subClamped(head, finalizedDepth), nil
+    }
+
+    if !strings.HasPrefix(tag, "0x") {
+        return 0, &Error{Code: -32602, Message: "invalid block number " + tag}
+    }
+
+    number, err := strconv.ParseUint(tag[2:], 16, 64)
+    if err != nil {
+        return 0, &Error{Code: -32602, Message: "invalid block number " + tag}
+    }
+    return number, nil
+}
+
+// stateBlockParam resolves the block of a state query, given as a number,
+// a tag or an EIP-1898 object.
+func stateBlockParam(s *Server, params []json.RawMessage, i int) (uint64, *Error) {
+    if i >= len(params) {
+        return 0, &Error{Code: -32602, Message: "missing value for required argument " + strconv.Itoa(i)}
+    }
+
+    var selector blockSelector
+    if json.Unmarshal(params[i], &selector.BlockNumber) != nil && json.Unmarshal(params[i], &selector) != nil {
+        return 0, &Error{Code: -32602, Message: "invalid argument " + strconv.Itoa(i) + ": invalid block number or hash"}
+    }
+
+    if selector.BlockHash != "" {
+        block, ok := s.Chain.BlockByHash(selector.BlockHash)
+        if !ok {
+            return 0, &Error{Code: -32000, Message: "header for hash not found"}
+        }
+        number, _ := strconv.ParseUint(block.Number[2:], 16, 64)
+        return number, nil
+    }
+
+    number, rpcErr := resolveBlockNumber(s, selector.BlockNumber)
+    if rpcErr != nil {
+        return 0, rpcErr
+    }
+    if _, ok := s.Chain.BlockByNumber(number); !ok {
+        return 0, &Error{Code: -32000, Message: "header not found"}
+    }
+    return number, nil
+}
+
+func addressParam(params []json.RawMessage, i int) (string, *Error) {
+    address, rpcErr := stringParam(params, i)
+    if rpcErr != nil {
+        return "", rpcErr
+    }
+
+    if len(address) != 42 || !strings.HasPrefix(address, "0x") {
+        return "", &Error{Code: -32602, Message: "invalid argument " + strconv.Itoa(i) + ": hex string has length " + strconv.Itoa(len(address) - 2) + ", want 40 for common.Address"}
+    }
+    return address, nil
+}
+
+func hashParam(params []json.RawMessage, i int) (string, *Error) {
+    hash, rpcErr := stringParam(params, i)
+    if rpcErr != nil {
+        return "", rpcErr
+    }
+
+    if len(hash) != 66 || !strings.HasPrefix(hash, "0x") {
+        return "", &Error{Code: -32602, Message: "invalid argument " + strconv.Itoa(i) + ": hex string has length " + strconv.Itoa(len(hash) - 2) + ", want 64 for common.Hash"}
+    }
+    return hash, nil
+}
+
+func subClamped(a uint64, b uint64) uint64 {
+    if a < b {
+        return 0
+    }
+    return a - b
+}
\ No newline at end of file
@@ -0,0 +1,429 @@
+// Package fakegeth serves the Ethereum JSON-RPC API from a generated,
+// deterministic in-memory chain, with hooks to inject faults. It stands in
+// for geth in tests and local development.
+package fakegeth
+
+import (
+    "encoding/json"
+    "io/ioutil"
+    "net/http"
+    "strconv"
+    "sync"
+    "time"
+
+    "github.com/gorilla/websocket"
+)
+
+const clientVersion string = "FakeGeth/v1.0.0"
+
+// Error is a JSON-RPC error object.
+type Error struct {
+    Code int `json:"code"`
+    Message string `json:"message"`
+    Data interface{} `json:"data,omitempty"`
+}
+
+// Fault is injected into matching calls. Delay applies first; then the call
+// is answered with HTTPStatus, dropped, answered with Error or with a null
+// result, whichever is set first.
+type Fault struct {
+    // Method matches calls of one method, "" matches every call.
+    Method string
+    // Times limits how many calls the fault hits, 0 means until cleared.
+    Times int
+    Delay time.Duration
+    // HTTPStatus fails the whole HTTP request with this status.
+    HTTPStatus int
+    // Drop closes the connection without answering.
+    Drop bool
+    Error *Error
+    NullResult bool
+}
+
+type request struct {
+    Jsonrpc string `json:"jsonrpc"`
+    Id json.RawMessage `json:"id"`
+    Method string `json:"method"`
+    Params json.RawMessage `json:"params"`
+}
+
+type response struct {
+    Jsonrpc string `json:"jsonrpc"`
+    Id json.RawMessage `json:"id"`
+    Result json.RawMessage `json:"result,omitempty"`
+    Error *Error `json:"error,omitempty"`
+}
+
+type notification struct {
+    Jsonrpc string `json:"jsonrpc"`
+    Method string `json:"method"`
+    Params struct {
+        Subscription string `json:"subscription"`
+        Result interface{} `json:"result"`
+    } `json:"params"`
+}
+
+type wsClient struct {
+    conn *websocket.Conn
+    writeMu sync.Mutex
+}
+
+func (c *wsClient) write(v interface{}) error {
+    c.writeMu.Lock()
+    defer c.writeMu.Unlock()
+
+    return c.conn.WriteJSON(v)
+}
+
+/* ----- SERVER ----- */
+
+// Server answers Ethereum JSON-RPC calls from a Chain over HTTP, including
+// batches, and over WebSocket, including newHeads subscriptions. It is an
+// http.Handler, so it runs under httptest.NewServer or http.ListenAndServe.
+type Server struct {
+    Chain *Chain
+    // MaxLogs makes eth_getLogs fail with -32005 like a node enforcing a
+    // result limit, 0 for no limit.
+    MaxLogs int
+    // MaxLogsRange makes eth_getLogs fail with -32005 for ranges of more
+    // blocks, 0 for no limit.
+    MaxLogsRange uint64
+
+    mu sync.Mutex
+    faults []*Fault
+    calls map[string]int
+    subs map[string]*wsClient
+    nextSubId int
+
+    upgrader websocket.Upgrader
+}
+
+func NewServer(chain *Chain) *Server {
+    s := &Server{
+        Chain: chain,
+        calls: map[string]int{},
+        subs: map[string]*wsClient{},
+    }
+
+    chain.OnNewHead(s.notifyNewHead)
+    return s
+}
+
+// InjectFault adds fault; faults are matched in the order they were added.
+func (s *Server) InjectFault(fault Fault) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    s.faults = append(s.faults, &fault)
+}
+
+func (s *Server) ClearFaults() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    s.faults = nil
+}
+
+// Calls returns how many times method was called, "" for all methods.
+func (s *Server) Calls(method string) int {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if method != "" {
+        return s.calls[method]
+    }
+
+    total := 0
+    for _, count := range s.calls {
+        total += count
+    }
+    return total
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+    if websocket.IsWebSocketUpgrade(r) {
+        s.serveWS(w, r)
+        return
+    }
+
+    if r.Method != "POST" {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+
+    body, err := ioutil.ReadAll(r.Body)
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+
+    reqs, batch, rpcErr := parseRequests(body)
+    if rpcErr != nil {
+        writeJSON(w, response{Jsonrpc: "2.0", Id: json.RawMessage("null"), Error: rpcErr})
+        return
+    }
+
+    faults := make([]*Fault, len(reqs))
+    for i, req := range reqs {
+        faults[i] = s.takeFault(req.Method)
+        if faults[i] == nil {
+            continue
+        }
+
+        time.Sleep(faults[i].Delay)
+        if faults[i].HTTPStatus != 0 {
+            http.Error(w, http.StatusText(faults[i].HTTPStatus), faults[i].HTTPStatus)
+            return
+        }
+        if faults[i].Drop {
+            dropConnection(w)
+            return
+        }
+    }
+
+    resps := make([]response, len(reqs))
+    for i, req := range reqs {
+        resps[i] = s.answer(req, faults[i], nil)
+    }
+
+    if batch {
+        writeJSON(w, resps)
+    } else {
+        writeJSON(w, resps[0])
+    }
+}
+
+func (s *Server) serveWS(w http.ResponseWriter, r *http.Request) {
+    conn, err := s.upgrader.Upgrade(w, r, nil)
+    if err != nil {
+        return
+    }
+
+    client := &wsClient{conn: conn}
+    defer s.dropSubscriptions(client)
+    defer conn.Close()
+
+    for {
+        _, msg, err := conn.ReadMessage()
+        if err != nil {
+            return
+        }
+
+        reqs, batch, rpcErr := parseRequests(msg)
+        if rpcErr != nil {
+            client.write(response{Jsonrpc: "2.0", Id: json.RawMessage("null"), Error: rpcErr})
+            continue
+        }
+
+        resps := make([]response, len(reqs))
+        for i, req := range reqs {
+            fault := s.takeFault(req.Method)
+            if fault != nil {
+                time.Sleep(fault.Delay)
+                if fault.Drop || fault.HTTPStatus != 0 {
+                    return
+                }
+            }
+            resps[i] = s.answer(req, fault, client)
+        }
+
+        if batch {
+            err = client.write(resps)
+        } else {
+            err = client.write(resps[0])
+        }
+        if err != nil {
+            return
+        }
+    }
+}
+
+// answer runs req, or fails it as fault says. client is nil for HTTP calls,
+// which cannot subscribe.
+func (s *Server) answer(req request, fault *Fault, client *wsClient) response {
+    resp := response{Jsonrpc: "2.0", Id: req.Id}
+
+    s.mu.Lock()
+    s.calls[req.Method]++
+    s.mu.Unlock()
+
+    if fault != nil && fault.Error != nil {
+        resp.Error = fault.Error
+        return resp
+    }
+
+    if fault != nil && fault.NullResult {
+        resp.Result = json.RawMessage("null")
+        return resp
+    }
+
+    var params []json.RawMessage
+    if len(req.Params) > 0 && json.Unmarshal(req.Params, &params) != nil {
+        resp.Error = &Error{Code: -32602, Message: "invalid params: params must be an array"}
+        return resp
+    }
+
+    var result interface{}
+    var rpcErr *Error
+    switch req.Method {
+    case "eth_subscribe":
+        result, rpcErr = s.subscribe(client, params)
+    case "eth_unsubscribe":
+        result, rpcErr = s.unsubscribe(client, params)
+    default:
+        method, ok := methods[req.Method]
+        if !ok {
+            resp.Error = &Error{Code: -32601, Message: "the method " + req.Method + " does not exist/is not available"}
+            return resp
+        }
+        result, rpcErr = method(s, params)
+    }
+
+    if rpcErr != nil {
+        resp.Error = rpcErr
+        return resp
+    }
+
+    resp.Result, _ = json.Marshal(result)
+    return resp
+}
+
+func (s *Server) takeFault(method string) *Fault {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for i, fault := range s.faults {
+        if fault.Method != "" && fault.Method != method {
+            continue
+        }
+
+        if fault.Times > 0 {
+            fault.Times--
+            if fault.Times == 0 {
+                s.faults = append(s.faults[:i], s.faults[i + 1:]...)
+            }
+        }
+        return fault
+    }
+
+    return nil
+}
+
+/* ----- SUBSCRIPTIONS ----- */
+func (s *Server) subscribe(client *wsClient, params []json.RawMessage) (interface{}, *Error) {
+    if client == nil {
+        return nil, &Error{Code: -32601, Message: "notifications not supported"}
+    }
+
+    kind, rpcErr := stringParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+    if kind != "newHeads" {
+        return nil, &Error{Code: -32602, Message: "unsupported subscription type " + kind}
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    s.nextSubId++
+    id := hexUint(uint64(s.nextSubId))
+    s.subs[id] = client
+    return id, nil
+}
+
+func (s *Server) unsubscribe(client *wsClient, params []json.RawMessage) (interface{}, *Error) {
+    id, rpcErr := stringParam(params, 0)
+    if rpcErr != nil {
+        return nil, rpcErr
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.subs[id] != client {
+        return false, nil
+    }
+    delete(s.subs, id)
+    return true, nil
+}
+
+func (s *Server) dropSubscriptions(client *wsClient) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for id, subClient := range s.subs {
+        if subClient == client {
+            delete(s.subs, id)
+        }
+    }
+}
+
+func (s *Server) notifyNewHead(block *Block) {
+    s.mu.Lock()
+    subs := map[string]*wsClient{}
+    for id, client := range s.subs {
+        subs[id] = client
+    }
+    s.mu.Unlock()
+
+    for id, client := range subs {
+        msg := notification{Jsonrpc: "2.0", Method: "eth_subscription"}
+        msg.Params.Subscription = id
+        msg.Params.Result = block
+        client.write(msg)
+    }
+}
+
+func parseRequests(body []byte) ([]request, bool, *Error) {
+    var reqs []request
+    if json.Unmarshal(body, &reqs) == nil {
+        if len(reqs) == 0 {
+            return nil, false, &Error{Code: -32600, Message: "empty batch"}
+        }
+        return reqs, true, nil
+    }
+
+    var req request
+    if json.Unmarshal(body, &req) != nil {
+        return nil, false, &Error{Code: -32700, Message: "parse error"}
+    }
+    return []request{req}, false, nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+    w.Header().Set("Content-Type", "application/json")
+    json.NewEncoder(w).Encode(v)
+}
+
+func dropConnection(w http.ResponseWriter) {
+    hijacker, ok := w.(http.Hijacker)
+    if !ok {
+        http.Error(w, "connection dropped", http.StatusBadGateway)
+        return
+    }
+
+    conn, _, err := hijacker.Hijack()
+    if err == nil {
+        conn.Close()
+    }
+}
+
+func stringParam(params []json.RawMessage, i int) (string, *Error) {
+    var value string
+    if i >= len(params) || json.Unmarshal(params[i], &value) != nil {
+        return "", &Error{Code: -32602, Message: "invalid argument " + strconv.Itoa(i) + ": expected string"}
+    }
+    return value, nil
+}
+
+func boolParam(params []json.RawMessage, i int) (bool, *Error) {
+    var value bool
+    if i >= len(params) {
+        return false, nil
+    }
+    if json.Unmarshal(params[i], &value) != nil {
+        return false, &Error{Code: -32602, Message: "invalid argument " + strconv.Itoa(i) + ": expected bool"}
+    }
+    return value, nil
+}
\ No newline at end of file
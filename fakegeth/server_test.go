@@ -0,0 +1,133 @@
+package fakegeth_test
+
+import (
+    "bytes"
+    "encoding/json"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+
+    "github.com/herrjemand/gethGoKitRPCMicroService/fakegeth"
+)
+
+type rpcResponse struct {
+    Id int `json:"id"`
+    Result json.RawMessage `json:"result"`
+    Error *fakegeth.Error `json:"error"`
+}
+
+// post sends body to the node and returns the status code and body of the
+// answer.
+func post(t *testing.T, url string, body string) (int, []byte) {
+    resp, err := http.Post(url, "application/json", bytes.NewBufferString(body))
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer resp.Body.Close()
+
+    respBody, err := ioutil.ReadAll(resp.Body)
+    if err != nil {
+        t.Fatal(err)
+    }
+    return resp.StatusCode, respBody
+}
+
+func call(t *testing.T, url string, method string) rpcResponse {
+    code, body := post(t, url, `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":[]}`)
+    if code != http.StatusOK {
+        t.Fatalf("%s: status %d", method, code)
+    }
+
+    var resp rpcResponse
+    if err := json.Unmarshal(body, &resp); err != nil {
+        t.Fatal(err)
+    }
+    return resp
+}
+
+func TestBatchesAnswerInOrder(t *testing.T) {
+    chain := fakegeth.NewChain(fakegeth.DefaultChainConfig)
+    server := fakegeth.NewServer(chain)
+    node := httptest.NewServer(server)
+    defer node.Close()
+
+    code, body := post(t, node.URL, `[
+        {"jsonrpc":"2.0","id":7,"method":"eth_blockNumber","params":[]},
+        {"jsonrpc":"2.0","id":3,"method":"eth_chainId","params":[]},
+        {"jsonrpc":"2.0","id":5,"method":"eth_unknown","params":[]}]`)
+    if code != http.StatusOK {
+        t.Fatalf("status %d", code)
+    }
+
+    var resps []rpcResponse
+    if err := json.Unmarshal(body, &resps); err != nil {
+        t.Fatal(err)
+    }
+    if len(resps) != 3 || resps[0].Id != 7 || resps[1].Id != 3 || resps[2].Id != 5 {
+        t.Fatalf("got %s", body)
+    }
+    if string(resps[0].Result) != `"0x10"` || string(resps[1].Result) != `"0x539"` {
+        t.Errorf("got %s", body)
+    }
+    if resps[2].Error == nil || resps[2].Error.Code != -32601 {
+        t.Errorf("unknown method answered %s", body)
+    }
+    if server.Calls("") != 3 || server.Calls("eth_chainId") != 1 {
+        t.Errorf("counted %d calls, %d of eth_chainId", server.Calls(""), server.Calls("eth_chainId"))
+    }
+}
+
+func TestFaultsMatchInOrderAndExpire(t *testing.T) {
+    chain := fakegeth.NewChain(fakegeth.DefaultChainConfig)
+    server := fakegeth.NewServer(chain)
+    node := httptest.NewServer(server)
+    defer node.Close()
+
+    server.InjectFault(fakegeth.Fault{Method: "eth_blockNumber", Times: 2, Error: &fakegeth.Error{Code: -32000, Message: "boom"}})
+    server.InjectFault(fakegeth.Fault{Method: "eth_blockNumber", Times: 1, NullResult: true})
+    server.InjectFault(fakegeth.Fault{Method: "eth_chainId", HTTPStatus: http.StatusTooManyRequests})
+
+    for i := 0; i < 2; i++ {
+        if resp := call(t, node.URL, "eth_blockNumber"); resp.Error == nil || resp.Error.Message != "boom" {
+            t.Fatalf("call %d answered %+v, want the injected error", i, resp)
+        }
+    }
+    if resp := call(t, node.URL, "eth_blockNumber"); string(resp.Result) != "null" || resp.Error != nil {
+        t.Fatalf("got %+v, want a null result", resp)
+    }
+    if resp := call(t, node.URL, "eth_blockNumber"); string(resp.Result) != `"0x10"` {
+        t.Fatalf("got %+v once the faults expired", resp)
+    }
+
+    for i := 0; i < 2; i++ {
+        if code, _ := post(t, node.URL, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`); code != http.StatusTooManyRequests {
+            t.Fatalf("status %d, want 429 until cleared", code)
+        }
+    }
+    if server.Calls("eth_chainId") != 0 {
+        t.Errorf("calls failed at the HTTP level were counted")
+    }
+
+    server.ClearFaults()
+    if resp := call(t, node.URL, "eth_chainId"); string(resp.Result) != `"0x539"` {
+        t.Errorf("got %+v after clearing faults", resp)
+    }
+}
+
+func TestDroppedConnectionsGetNoAnswer(t *testing.T) {
+    chain := fakegeth.NewChain(fakegeth.DefaultChainConfig)
+    server := fakegeth.NewServer(chain)
+    node := httptest.NewServer(server)
+    defer node.Close()
+
+    server.InjectFault(fakegeth.Fault{Times: 1, Drop: true})
+    _, err := http.Post(node.URL, "application/json", bytes.NewBufferString(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`))
+    if err == nil {
+        t.Fatal("dropped request got an answer")
+    }
+
+    if resp := call(t, node.URL, "eth_chainId"); string(resp.Result) != `"0x539"` {
+        t.Errorf("got %+v after the drop", resp)
+    }
+}
\ No newline at end of file
@@ -0,0 +1,78 @@
+package fakegeth
+
+import (
+    "strconv"
+    "strings"
+)
+
+// CallFrame is the result of debug_traceTransaction with callTracer.
+type CallFrame struct {
+    Type string `json:"type"`
+    From string `json:"from"`
+    To string `json:"to,omitempty"`
+    Value string `json:"value,omitempty"`
+    Gas string `json:"gas"`
+    GasUsed string `json:"gasUsed"`
+    Input string `json:"input"`
+    Output string `json:"output,omitempty"`
+    Error string `json:"error,omitempty"`
+    RevertReason string `json:"revertReason,omitempty"`
+    Calls []CallFrame `json:"calls,omitempty"`
+}
+
+// PrestateAccount is an account in the result of debug_traceTransaction
+// with prestateTracer.
+type PrestateAccount struct {
+    Balance string `json:"balance"`
+    Nonce uint64 `json:"nonce,omitempty"`
+    Code string `json:"code,omitempty"`
+}
+
+// TraceCall returns the call tree of a mined transaction. Generated
+// transactions make no calls of their own, so the tree is a single frame.
+func (c *Chain) TraceCall(hash string) (CallFrame, bool) {
+    tx, ok := c.TransactionByHash(hash)
+    if !ok {
+        return CallFrame{}, false
+    }
+    receipt, _ := c.ReceiptByHash(hash)
+
+    frame := CallFrame{
+        Type: "CALL",
+        From: tx.From,
+        To: tx.To,
+        Value: tx.Value,
+        Gas: tx.Gas,
+        GasUsed: receipt.GasUsed,
+        Input: tx.Input,
+    }
+    if tx.Input != "0x" {
+        // transfer(to, value) returns true.
+        frame.Output = "0x" + pad32("1")
+    }
+    return frame, true
+}
+
+// TracePrestate returns the accounts a mined transaction touched as they
+// were before its block, as the chain keeps no state between transactions.
+func (c *Chain) TracePrestate(hash string) (map[string]PrestateAccount, bool) {
+    tx, ok := c.TransactionByHash(hash)
+    if !ok {
+        return nil, false
+    }
+    number, _ := strconv.ParseUint(tx.BlockNumber[2:], 16, 64)
+    block, _ := c.BlockByNumber(number)
+
+    prestate := map[string]PrestateAccount{}
+    for _, address := range []string{tx.From, tx.To, block.Miner} {
+        account := PrestateAccount{
+            Balance: "0x" + c.BalanceAt(address, number - 1).Text(16),
+            Nonce: c.NonceAt(address, number - 1),
+        }
+        if code := c.CodeAt(address); code != "0x" {
+            account.Code = code
+        }
+        prestate[strings.ToLower(address)] = account
+    }
+    return prestate, true
+}
\ No newline at end of file
@@ -1,9 +1,15 @@
 package main
 
 import (
+    "encoding/json"
+    "flag"
+    "io/ioutil"
     "log"
+    "math/big"
     "time"
     "net/http"
+    "strconv"
+    "strings"
     "github.com/herrjemand/gethGoKitRPCMicroService/router"
     "github.com/herrjemand/gethGoKitRPCMicroService/proto"
 
@@ -15,13 +21,184 @@ import (
 const serverAddress string = "127.0.0.1"
 const httpServerPort string = "8080"
 const grpcServerPort string = "9090"
+const metricsServerPort string = "6060"
+const gethUrl string = "http://localhost:8545"
+const gethMaxBatchSize int = 100
+const gethMaxInFlight int = 16
+
+var retryAttempts = flag.Int("retry-attempts", router.DefaultRetryPolicy.MaxAttempts, "max attempts for idempotent upstream calls")
+var upstreamUrls = flag.String("upstream", gethUrl, "comma separated geth upstream urls (http://, https://, ws://, wss:// or ipc:///path/to/geth.ipc)")
+var upstreamRps = flag.Float64("upstream-rps", 0, "max JSON-RPC requests per second sent to each upstream, 0 for unlimited")
+var upstreamMaxInFlight = flag.Int("upstream-max-in-flight", gethMaxInFlight, "max concurrent calls to each upstream, 0 for unlimited")
+var maxBlockLag = flag.Int64("max-block-lag", router.DefaultMaxBlockLag, "blocks an upstream may trail the highest head before it is taken out of rotation")
+var quorumMethods = flag.String("quorum", "", "comma separated method=N/M quorum reads, e.g. eth_getTransactionByBlockHashAndIndex=2/3")
+var recordFile = flag.String("record", "", "append every upstream request and response to this JSONL file")
+var replayFile = flag.String("replay", "", "answer from a JSONL recording instead of upstream nodes")
+var replayStrict = flag.Bool("replay-strict", false, "fail calls missing from the -replay recording instead of answering null")
+var upstreamConfigFile = flag.String("upstream-config", "", "JSON file listing upstreams with per-upstream auth, overrides -upstream")
+var logsChunkSize = flag.Int64("logs-chunk-size", router.DefaultLogsChunkSize, "blocks asked for in one eth_getLogs call before shrinking on node limits")
+var abiDir = flag.String("abi-dir", "", "directory of contract ABI *.json files to register under their file names, which POST /abis cannot replace")
+var txFeeCap = flag.Float64("tx-fee-cap", 1, "max fees in ether of a transaction passed on by /sendRawTransaction, 0 for no cap")
+var feeCacheTTL = flag.Duration("fee-cache-ttl", router.DefaultFeeCacheTTL, "how long /suggestFees answers from cache before asking the node again")
+var debugTokenFile = flag.String("debug-token-file", "", "file of tokens, one per line, allowed to call /traceTransaction and POST /abis; both are off without it")
+var maxConcurrentTraces = flag.Int("max-concurrent-traces", router.DefaultMaxConcurrentTraces, "max debug_traceTransaction calls running on the node at once")
+var tokenCacheSize = flag.Int("token-cache-size", router.DefaultTokenCacheSize, "tokens whose name, symbol and decimals are kept in memory, 0 to always ask the node")
+var retryMethods = flag.String("retry-method", "", "comma separated method=N max attempts overriding -retry-attempts, e.g. eth_call=1,eth_sendRawTransaction=3")
+var metricsAddr = flag.String("metrics-addr", serverAddress + ":" + metricsServerPort, "address serving /debug/vars metrics apart from the API, off when empty")
+var maxABIs = flag.Int("max-abis", router.DefaultMaxABIs, "max ABIs POST /abis may register besides those of -abi-dir")
+
+// loadUpstreamConfig reads a JSON array of upstreams, e.g.
+//     [{"url": "http://localhost:8551", "auth": {"jwtSecretFile": "/data/jwt.hex"}},
+//      {"url": "wss://node.example.com", "auth": {"username": "user", "password": "pass"},
+//       "limit": {"requestsPerSecond": 50, "burst": 100, "maxInFlight": 8}}]
+// Upstreams without a "limit" get the limits from the command line flags.
+func loadUpstreamConfig(path string) ([]router.RPCClientConfig, error) {
+    data, err := ioutil.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+
+    upstreams := []router.RPCClientConfig{}
+    err = json.Unmarshal(data, &upstreams)
+    if err != nil {
+        return nil, err
+    }
+
+    for i := range upstreams {
+        if upstreams[i].MaxBatchSize == 0 {
+            upstreams[i].MaxBatchSize = gethMaxBatchSize
+        }
+        if upstreams[i].Limit == (router.LimitConfig{}) {
+            upstreams[i].Limit = defaultUpstreamLimit()
+        }
+    }
+
+    return upstreams, nil
+}
+
+func defaultUpstreamLimit() router.LimitConfig {
+    return router.LimitConfig{
+        RequestsPerSecond: *upstreamRps,
+        MaxInFlight: *upstreamMaxInFlight,
+    }
+}
+
+// startPool builds the upstream pool from the command line and starts its
+// health checks.
+func startPool() (*router.PoolRPCClient, error) {
+    upstreams := []router.RPCClientConfig{}
+    if *upstreamConfigFile != "" {
+        var err error
+        upstreams, err = loadUpstreamConfig(*upstreamConfigFile)
+        if err != nil {
+            return nil, err
+        }
+    } else {
+        for _, upstreamUrl := range strings.Split(*upstreamUrls, ",") {
+            upstreams = append(upstreams, router.RPCClientConfig{
+                Url: strings.TrimSpace(upstreamUrl),
+                MaxBatchSize: gethMaxBatchSize,
+                Limit: defaultUpstreamLimit(),
+            })
+        }
+    }
+
+    pool, err := router.NewPoolRPCClient(upstreams)
+    if err != nil {
+        return nil, err
+    }
+    pool.MaxBlockLag = *maxBlockLag
+    if *quorumMethods != "" {
+        for _, methodQuorum := range strings.Split(*quorumMethods, ",") {
+            parts := strings.SplitN(methodQuorum, "=", 2)
+            if len(parts) != 2 {
+                return nil, router.ErrInvalidQuorum
+            }
+
+            quorum, err := router.ParseQuorum(parts[1])
+            if err != nil {
+                return nil, err
+            }
+            pool.QuorumMethods[strings.TrimSpace(parts[0])] = quorum
+        }
+    }
+    pool.Start()
+
+    return pool, nil
+}
 
 func main() {
-    svc := router.EthServiceImp{}
+    flag.Parse()
+
+    var upstream router.RPCClient
+    if *replayFile != "" {
+        replay, err := router.NewReplayRPCClient(*replayFile)
+        if err != nil {
+            log.Fatal(err)
+        }
+        replay.Strict = *replayStrict
+        upstream = replay
+    } else {
+        pool, err := startPool()
+        if err != nil {
+            log.Fatal(err)
+        }
+        upstream = pool
+
+        if *recordFile != "" {
+            recorder, err := router.NewRecordingRPCClient(pool, *recordFile)
+            if err != nil {
+                log.Fatal(err)
+            }
+            upstream = recorder
+        }
+    }
+
+    retryPolicy := router.DefaultRetryPolicy
+    retryPolicy.MaxAttempts = *retryAttempts
+    client := router.NewRetryRPCClient(upstream, retryPolicy)
+    if *retryMethods != "" {
+        for _, methodAttempts := range strings.Split(*retryMethods, ",") {
+            parts := strings.SplitN(methodAttempts, "=", 2)
+            if len(parts) != 2 {
+                log.Fatal(router.ErrInvalidRetryMethod)
+            }
+
+            attempts, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+            if err != nil || attempts < 1 {
+                log.Fatal(router.ErrInvalidRetryMethod)
+            }
+
+            methodPolicy := retryPolicy
+            methodPolicy.MaxAttempts = attempts
+            client.MethodPolicies[strings.TrimSpace(parts[0])] = methodPolicy
+        }
+    }
+    svc := router.NewEthService(client)
+    svc.LogsChunkSize = *logsChunkSize
+    svc.Fees.TTL = *feeCacheTTL
+    svc.Tokens.MaxTokens = *tokenCacheSize
+    svc.ABIs.MaxABIs = *maxABIs
+    svc.TxFeeCap, _ = new(big.Float).Mul(big.NewFloat(*txFeeCap), big.NewFloat(1e18)).Int(nil)
+    if *abiDir != "" {
+        err := svc.ABIs.LoadDir(*abiDir)
+        if err != nil {
+            log.Fatal(err)
+        }
+    }
+    svc.Debug = router.NewDebugAccess(nil, *maxConcurrentTraces)
+    if *debugTokenFile != "" {
+        tokens, err := router.LoadDebugTokens(*debugTokenFile)
+        if err != nil {
+            log.Fatal(err)
+        }
+        svc.Debug.Tokens = tokens
+    }
+    breaker := router.NewCircuitBreaker(router.DefaultCircuitBreakerConfig)
 
     errors := make(chan error)
     go func() {
-        router := router.GenerateHTTPRouter(svc)
+        router := router.GenerateHTTPRouter(svc, breaker.Middleware())
         server := &http.Server{
             Handler:      router.(http.Handler),
             Addr:         serverAddress + ":" + httpServerPort,
@@ -34,6 +211,16 @@ func main() {
         errors <- server.ListenAndServe()
     }()
 
+    if *metricsAddr != "" {
+        go func() {
+            mux := http.NewServeMux()
+            mux.Handle("/debug/vars", router.MetricsHandler())
+            log.Println("Starting metrics server at " + *metricsAddr + "...")
+
+            errors <- http.ListenAndServe(*metricsAddr, mux)
+        }()
+    }
+
     ctx := context.Background()
 
     go func() {
@@ -44,7 +231,7 @@ func main() {
         }
 
         gRPCServer := grpc.NewServer()
-        proto.RegisterEthGRPCServer(gRPCServer, router.GetGethGRPCEndpoints(ctx, svc))
+        proto.RegisterEthGRPCServer(gRPCServer, router.GetGethGRPCEndpoints(ctx, svc, breaker.Middleware()))
 
         log.Println("Starting gRPC server at " + serverAddress + ":" + grpcServerPort + "...")
 
@@ -22,6 +22,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type AccountStateKind int32
+
+const (
+	AccountStateKind_BALANCE           AccountStateKind = 0
+	AccountStateKind_TRANSACTION_COUNT AccountStateKind = 1
+	AccountStateKind_CODE              AccountStateKind = 2
+	AccountStateKind_STORAGE           AccountStateKind = 3
+)
+
+var AccountStateKind_name = map[int32]string{
+	0: "BALANCE",
+	1: "TRANSACTION_COUNT",
+	2: "CODE",
+	3: "STORAGE",
+}
+
+var AccountStateKind_value = map[string]int32{
+	"BALANCE":           0,
+	"TRANSACTION_COUNT": 1,
+	"CODE":              2,
+	"STORAGE":           3,
+}
+
+func (x AccountStateKind) String() string {
+	return proto.EnumName(AccountStateKind_name, int32(x))
+}
+
+func (AccountStateKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{0}
+}
+
 type GetSyncRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -203,23 +234,28 @@ func (m *GetTxsForBlockHashRequest) GetBlockHash() string {
 }
 
 type Transaction struct {
-	BlockHash            string   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
-	BlockNumber          string   `protobuf:"bytes,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
-	From                 string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	Gas                  string   `protobuf:"bytes,4,opt,name=gas,proto3" json:"gas,omitempty"`
-	GasPrice             string   `protobuf:"bytes,5,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
-	Hash                 string   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
-	Input                string   `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
-	Nonce                string   `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	To                   string   `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
-	TransactionIndex     string   `protobuf:"bytes,10,opt,name=transactionIndex,proto3" json:"transactionIndex,omitempty"`
-	Value                string   `protobuf:"bytes,11,opt,name=value,proto3" json:"value,omitempty"`
-	V                    string   `protobuf:"bytes,12,opt,name=v,proto3" json:"v,omitempty"`
-	R                    string   `protobuf:"bytes,13,opt,name=r,proto3" json:"r,omitempty"`
-	S                    string   `protobuf:"bytes,14,opt,name=s,proto3" json:"s,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	BlockHash            string           `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockNumber          string           `protobuf:"bytes,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	From                 string           `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	Gas                  string           `protobuf:"bytes,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPrice             string           `protobuf:"bytes,5,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	Hash                 string           `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	Input                string           `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Nonce                string           `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	To                   string           `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
+	TransactionIndex     string           `protobuf:"bytes,10,opt,name=transactionIndex,proto3" json:"transactionIndex,omitempty"`
+	Value                string           `protobuf:"bytes,11,opt,name=value,proto3" json:"value,omitempty"`
+	V                    string           `protobuf:"bytes,12,opt,name=v,proto3" json:"v,omitempty"`
+	R                    string           `protobuf:"bytes,13,opt,name=r,proto3" json:"r,omitempty"`
+	S                    string           `protobuf:"bytes,14,opt,name=s,proto3" json:"s,omitempty"`
+	Type                 string           `protobuf:"bytes,15,opt,name=type,proto3" json:"type,omitempty"`
+	ChainId              string           `protobuf:"bytes,16,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	MaxFeePerGas         string           `protobuf:"bytes,17,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string           `protobuf:"bytes,18,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
+	TokenMovements       []*TokenMovement `protobuf:"bytes,19,rep,name=tokenMovements,proto3" json:"tokenMovements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
@@ -345,6 +381,41 @@ func (m *Transaction) GetS() string {
 	return ""
 }
 
+func (m *Transaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Transaction) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Transaction) GetMaxFeePerGas() string {
+	if m != nil {
+		return m.MaxFeePerGas
+	}
+	return ""
+}
+
+func (m *Transaction) GetMaxPriorityFeePerGas() string {
+	if m != nil {
+		return m.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (m *Transaction) GetTokenMovements() []*TokenMovement {
+	if m != nil {
+		return m.TokenMovements
+	}
+	return nil
+}
+
 type GetTxsForBlockHashResponse struct {
 	Status               string         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	ErrorMessage         string         `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
@@ -400,151 +471,3603 @@ func (m *GetTxsForBlockHashResponse) GetTransactions() []*Transaction {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetSyncRequest)(nil), "proto.GetSyncRequest")
-	proto.RegisterType((*SyncInfo)(nil), "proto.SyncInfo")
-	proto.RegisterType((*GetSyncResponse)(nil), "proto.GetSyncResponse")
-	proto.RegisterType((*GetTxsForBlockHashRequest)(nil), "proto.GetTxsForBlockHashRequest")
-	proto.RegisterType((*Transaction)(nil), "proto.Transaction")
-	proto.RegisterType((*GetTxsForBlockHashResponse)(nil), "proto.GetTxsForBlockHashResponse")
+type GetBlockRequest struct {
+	// blockHash takes precedence over blockNumber, which is a number or one
+	// of latest, pending, earliest, safe and finalized.
+	BlockHash            string   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockNumber          string   `protobuf:"bytes,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	FullTransactions     bool     `protobuf:"varint,3,opt,name=fullTransactions,proto3" json:"fullTransactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("ethgrpc.proto", fileDescriptor_7b58a0e0835cfa32) }
+func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
+func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRequest) ProtoMessage()    {}
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{6}
+}
 
-var fileDescriptor_7b58a0e0835cfa32 = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe5, 0xa4, 0xf9, 0x1a, 0x27, 0x69, 0x34, 0x82, 0x6a, 0x89, 0x38, 0x04, 0x8b, 0x43,
-	0x05, 0x52, 0x0f, 0x41, 0x42, 0x70, 0x05, 0x41, 0xe8, 0x01, 0x54, 0x99, 0x5e, 0x38, 0x6e, 0xcc,
-	0xd6, 0xb6, 0x68, 0x77, 0xcd, 0xce, 0x3a, 0x0a, 0x3c, 0x05, 0x4f, 0xc0, 0x0b, 0xf1, 0x52, 0x68,
-	0x3f, 0x92, 0xd8, 0xb4, 0xea, 0x89, 0x93, 0xe7, 0xff, 0xf3, 0xdf, 0xf3, 0xb1, 0x3b, 0x86, 0x89,
-	0x30, 0x45, 0xae, 0xab, 0xec, 0xac, 0xd2, 0xca, 0x28, 0xec, 0xb9, 0x47, 0x32, 0x83, 0xe9, 0x4a,
-	0x98, 0xcf, 0x3f, 0x64, 0x96, 0x8a, 0xef, 0xb5, 0x20, 0x93, 0x6c, 0x61, 0x68, 0xe5, 0xb9, 0xbc,
-	0x52, 0xf8, 0x14, 0x26, 0x64, 0xb8, 0x36, 0xa5, 0xcc, 0xdf, 0x5c, 0xab, 0xec, 0x1b, 0x8b, 0x16,
-	0xd1, 0xe9, 0x28, 0x6d, 0x43, 0x4c, 0x60, 0x9c, 0xd5, 0x5a, 0x0b, 0x69, 0xbc, 0xa9, 0xe3, 0x4c,
-	0x2d, 0x66, 0x3d, 0x45, 0x99, 0x17, 0x82, 0x82, 0xa7, 0xeb, 0x3d, 0x4d, 0x96, 0xfc, 0x84, 0xe3,
-	0x7d, 0x2f, 0x54, 0x29, 0x49, 0x02, 0x4f, 0xa0, 0x4f, 0x86, 0x9b, 0x9a, 0x42, 0xe5, 0xa0, 0x6c,
-	0x3a, 0xa1, 0xb5, 0xd2, 0x1f, 0x05, 0x11, 0xcf, 0xc5, 0xae, 0x64, 0x93, 0xe1, 0x73, 0x18, 0x52,
-	0x18, 0xc4, 0x95, 0x8b, 0x97, 0xc7, 0x7e, 0xf6, 0xb3, 0xdd, 0x7c, 0xe9, 0xde, 0x90, 0xbc, 0x86,
-	0x47, 0x2b, 0x61, 0x2e, 0xb7, 0xf4, 0x5e, 0x69, 0xd7, 0xcd, 0x07, 0x4e, 0x45, 0x38, 0x12, 0x7c,
-	0x0c, 0xa3, 0xf5, 0x8e, 0x85, 0x46, 0x0e, 0x20, 0xf9, 0xd3, 0x81, 0xf8, 0x52, 0x73, 0x49, 0x3c,
-	0x33, 0xa5, 0x92, 0xf7, 0xbb, 0x71, 0x01, 0xb1, 0x13, 0x9f, 0xea, 0x9b, 0xb5, 0xd0, 0xa1, 0xf1,
-	0x26, 0x42, 0x84, 0xa3, 0x2b, 0xad, 0x6e, 0xc2, 0x11, 0xb9, 0x18, 0x67, 0xd0, 0xcd, 0x39, 0xb1,
-	0x23, 0x87, 0x6c, 0x88, 0x73, 0x18, 0xe6, 0x9c, 0x2e, 0x74, 0x99, 0x09, 0xd6, 0x73, 0x78, 0xaf,
-	0x6d, 0x86, 0xc2, 0x16, 0xef, 0xfb, 0x0c, 0x36, 0xc6, 0x07, 0xd0, 0x2b, 0x65, 0x55, 0x1b, 0x36,
-	0x70, 0xd0, 0x0b, 0x4b, 0xa5, 0x92, 0x99, 0x60, 0x43, 0x4f, 0x9d, 0xc0, 0x29, 0x74, 0x8c, 0x62,
-	0x23, 0x87, 0x3a, 0x46, 0xe1, 0x33, 0x98, 0x99, 0xc3, 0x80, 0xe7, 0xf2, 0xab, 0xd8, 0x32, 0x70,
-	0x6f, 0x6f, 0x71, 0x9b, 0x71, 0xc3, 0xaf, 0x6b, 0xc1, 0x62, 0x9f, 0xd1, 0x09, 0x1c, 0x43, 0xb4,
-	0x61, 0x63, 0x47, 0xa2, 0x8d, 0x55, 0x9a, 0x4d, 0xbc, 0xd2, 0x56, 0x11, 0x9b, 0x7a, 0x45, 0xc9,
-	0xaf, 0x08, 0xe6, 0x77, 0xdd, 0xc4, 0x7f, 0x58, 0x88, 0x97, 0x30, 0x6e, 0xb4, 0x4b, 0xac, 0xbb,
-	0xe8, 0x9e, 0xc6, 0x4b, 0x0c, 0x4b, 0xd1, 0xb8, 0xc2, 0xb4, 0xe5, 0x5b, 0xfe, 0x8e, 0x60, 0xf0,
-	0xce, 0x14, 0xab, 0xf4, 0xe2, 0x2d, 0xbe, 0x82, 0x41, 0xd8, 0x51, 0x7c, 0x18, 0x3e, 0x6c, 0xff,
-	0x3f, 0xf3, 0x93, 0x7f, 0x71, 0xe8, 0xfc, 0x0b, 0xe0, 0xed, 0xb9, 0x70, 0x71, 0x70, 0xdf, 0xbd,
-	0x7c, 0xf3, 0x27, 0xf7, 0x38, 0x7c, 0xea, 0x75, 0xdf, 0x39, 0x5e, 0xfc, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0x7c, 0x77, 0x0e, 0x28, 0xe3, 0x03, 0x00, 0x00,
+func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockRequest.Unmarshal(m, b)
+}
+func (m *GetBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRequest.Merge(m, src)
+}
+func (m *GetBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockRequest.Size(m)
+}
+func (m *GetBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_GetBlockRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetBlockRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
 
-// EthGRPCClient is the client API for EthGRPC service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type EthGRPCClient interface {
-	GetSync(ctx context.Context, in *GetSyncRequest, opts ...grpc.CallOption) (*GetSyncResponse, error)
-	GetTxsForBlockHash(ctx context.Context, in *GetTxsForBlockHashRequest, opts ...grpc.CallOption) (*GetTxsForBlockHashResponse, error)
+func (m *GetBlockRequest) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return ""
 }
 
-type ethGRPCClient struct {
-	cc *grpc.ClientConn
+func (m *GetBlockRequest) GetFullTransactions() bool {
+	if m != nil {
+		return m.FullTransactions
+	}
+	return false
 }
 
-func NewEthGRPCClient(cc *grpc.ClientConn) EthGRPCClient {
-	return &ethGRPCClient{cc}
+type Withdrawal struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	ValidatorIndex       string   `protobuf:"bytes,2,opt,name=validatorIndex,proto3" json:"validatorIndex,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *ethGRPCClient) GetSync(ctx context.Context, in *GetSyncRequest, opts ...grpc.CallOption) (*GetSyncResponse, error) {
-	out := new(GetSyncResponse)
-	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetSync", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
+func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
+func (*Withdrawal) ProtoMessage()    {}
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{7}
+}
+
+func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Withdrawal.Unmarshal(m, b)
+}
+func (m *Withdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Withdrawal.Marshal(b, m, deterministic)
+}
+func (m *Withdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Withdrawal.Merge(m, src)
+}
+func (m *Withdrawal) XXX_Size() int {
+	return xxx_messageInfo_Withdrawal.Size(m)
+}
+func (m *Withdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Withdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Withdrawal proto.InternalMessageInfo
+
+func (m *Withdrawal) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return ""
 }
 
-func (c *ethGRPCClient) GetTxsForBlockHash(ctx context.Context, in *GetTxsForBlockHashRequest, opts ...grpc.CallOption) (*GetTxsForBlockHashResponse, error) {
-	out := new(GetTxsForBlockHashResponse)
-	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetTxsForBlockHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Withdrawal) GetValidatorIndex() string {
+	if m != nil {
+		return m.ValidatorIndex
 	}
-	return out, nil
+	return ""
 }
 
-// EthGRPCServer is the server API for EthGRPC service.
-type EthGRPCServer interface {
-	GetSync(context.Context, *GetSyncRequest) (*GetSyncResponse, error)
-	GetTxsForBlockHash(context.Context, *GetTxsForBlockHashRequest) (*GetTxsForBlockHashResponse, error)
+func (m *Withdrawal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func RegisterEthGRPCServer(s *grpc.Server, srv EthGRPCServer) {
-	s.RegisterService(&_EthGRPC_serviceDesc, srv)
+func (m *Withdrawal) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func _EthGRPC_GetSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSyncRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type Block struct {
+	Number                string         `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Hash                  string         `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash            string         `protobuf:"bytes,3,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	Nonce                 string         `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Sha3Uncles            string         `protobuf:"bytes,5,opt,name=sha3Uncles,proto3" json:"sha3Uncles,omitempty"`
+	LogsBloom             string         `protobuf:"bytes,6,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
+	TransactionsRoot      string         `protobuf:"bytes,7,opt,name=transactionsRoot,proto3" json:"transactionsRoot,omitempty"`
+	StateRoot             string         `protobuf:"bytes,8,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
+	ReceiptsRoot          string         `protobuf:"bytes,9,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+	Miner                 string         `protobuf:"bytes,10,opt,name=miner,proto3" json:"miner,omitempty"`
+	Difficulty            string         `protobuf:"bytes,11,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	TotalDifficulty       string         `protobuf:"bytes,12,opt,name=totalDifficulty,proto3" json:"totalDifficulty,omitempty"`
+	ExtraData             string         `protobuf:"bytes,13,opt,name=extraData,proto3" json:"extraData,omitempty"`
+	Size                  string         `protobuf:"bytes,14,opt,name=size,proto3" json:"size,omitempty"`
+	GasLimit              string         `protobuf:"bytes,15,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	GasUsed               string         `protobuf:"bytes,16,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Timestamp             string         `protobuf:"bytes,17,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BaseFeePerGas         string         `protobuf:"bytes,18,opt,name=baseFeePerGas,proto3" json:"baseFeePerGas,omitempty"`
+	MixHash               string         `protobuf:"bytes,19,opt,name=mixHash,proto3" json:"mixHash,omitempty"`
+	WithdrawalsRoot       string         `protobuf:"bytes,20,opt,name=withdrawalsRoot,proto3" json:"withdrawalsRoot,omitempty"`
+	Withdrawals           []*Withdrawal  `protobuf:"bytes,21,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	BlobGasUsed           string         `protobuf:"bytes,22,opt,name=blobGasUsed,proto3" json:"blobGasUsed,omitempty"`
+	ExcessBlobGas         string         `protobuf:"bytes,23,opt,name=excessBlobGas,proto3" json:"excessBlobGas,omitempty"`
+	ParentBeaconBlockRoot string         `protobuf:"bytes,24,opt,name=parentBeaconBlockRoot,proto3" json:"parentBeaconBlockRoot,omitempty"`
+	Uncles                []string       `protobuf:"bytes,25,rep,name=uncles,proto3" json:"uncles,omitempty"`
+	TransactionHashes     []string       `protobuf:"bytes,26,rep,name=transactionHashes,proto3" json:"transactionHashes,omitempty"`
+	Transactions          []*Transaction `protobuf:"bytes,27,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}       `json:"-"`
+	XXX_unrecognized      []byte         `json:"-"`
+	XXX_sizecache         int32          `json:"-"`
+}
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{8}
+}
+
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	if interceptor == nil {
-		return srv.(EthGRPCServer).GetSync(ctx, in)
+	return ""
+}
+
+func (m *Block) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.EthGRPC/GetSync",
+	return ""
+}
+
+func (m *Block) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthGRPCServer).GetSync(ctx, req.(*GetSyncRequest))
+	return ""
+}
+
+func (m *Block) GetNonce() string {
+	if m != nil {
+		return m.Nonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EthGRPC_GetTxsForBlockHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTxsForBlockHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Block) GetSha3Uncles() string {
+	if m != nil {
+		return m.Sha3Uncles
 	}
-	if interceptor == nil {
-		return srv.(EthGRPCServer).GetTxsForBlockHash(ctx, in)
+	return ""
+}
+
+func (m *Block) GetLogsBloom() string {
+	if m != nil {
+		return m.LogsBloom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.EthGRPC/GetTxsForBlockHash",
+	return ""
+}
+
+func (m *Block) GetTransactionsRoot() string {
+	if m != nil {
+		return m.TransactionsRoot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthGRPCServer).GetTxsForBlockHash(ctx, req.(*GetTxsForBlockHashRequest))
+	return ""
+}
+
+func (m *Block) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _EthGRPC_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.EthGRPC",
-	HandlerType: (*EthGRPCServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSync",
-			Handler:    _EthGRPC_GetSync_Handler,
-		},
-		{
-			MethodName: "GetTxsForBlockHash",
-			Handler:    _EthGRPC_GetTxsForBlockHash_Handler,
+func (m *Block) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *Block) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *Block) GetDifficulty() string {
+	if m != nil {
+		return m.Difficulty
+	}
+	return ""
+}
+
+func (m *Block) GetTotalDifficulty() string {
+	if m != nil {
+		return m.TotalDifficulty
+	}
+	return ""
+}
+
+func (m *Block) GetExtraData() string {
+	if m != nil {
+		return m.ExtraData
+	}
+	return ""
+}
+
+func (m *Block) GetSize() string {
+	if m != nil {
+		return m.Size
+	}
+	return ""
+}
+
+func (m *Block) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *Block) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *Block) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *Block) GetBaseFeePerGas() string {
+	if m != nil {
+		return m.BaseFeePerGas
+	}
+	return ""
+}
+
+func (m *Block) GetMixHash() string {
+	if m != nil {
+		return m.MixHash
+	}
+	return ""
+}
+
+func (m *Block) GetWithdrawalsRoot() string {
+	if m != nil {
+		return m.WithdrawalsRoot
+	}
+	return ""
+}
+
+func (m *Block) GetWithdrawals() []*Withdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *Block) GetBlobGasUsed() string {
+	if m != nil {
+		return m.BlobGasUsed
+	}
+	return ""
+}
+
+func (m *Block) GetExcessBlobGas() string {
+	if m != nil {
+		return m.ExcessBlobGas
+	}
+	return ""
+}
+
+func (m *Block) GetParentBeaconBlockRoot() string {
+	if m != nil {
+		return m.ParentBeaconBlockRoot
+	}
+	return ""
+}
+
+func (m *Block) GetUncles() []string {
+	if m != nil {
+		return m.Uncles
+	}
+	return nil
+}
+
+func (m *Block) GetTransactionHashes() []string {
+	if m != nil {
+		return m.TransactionHashes
+	}
+	return nil
+}
+
+func (m *Block) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type GetBlockResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Block                *Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockResponse) Reset()         { *m = GetBlockResponse{} }
+func (m *GetBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResponse) ProtoMessage()    {}
+func (*GetBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{9}
+}
+
+func (m *GetBlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockResponse.Unmarshal(m, b)
+}
+func (m *GetBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResponse.Merge(m, src)
+}
+func (m *GetBlockResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockResponse.Size(m)
+}
+func (m *GetBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResponse proto.InternalMessageInfo
+
+func (m *GetBlockResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type Log struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics               []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	BlockNumber          string   `protobuf:"bytes,4,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	BlockHash            string   `protobuf:"bytes,5,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	TransactionHash      string   `protobuf:"bytes,6,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	TransactionIndex     string   `protobuf:"bytes,7,opt,name=transactionIndex,proto3" json:"transactionIndex,omitempty"`
+	LogIndex             string   `protobuf:"bytes,8,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	Removed              bool     `protobuf:"varint,9,opt,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Log) Reset()         { *m = Log{} }
+func (m *Log) String() string { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()    {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{10}
+}
+
+func (m *Log) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Log.Unmarshal(m, b)
+}
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+}
+func (m *Log) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Log.Merge(m, src)
+}
+func (m *Log) XXX_Size() int {
+	return xxx_messageInfo_Log.Size(m)
+}
+func (m *Log) XXX_DiscardUnknown() {
+	xxx_messageInfo_Log.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Log proto.InternalMessageInfo
+
+func (m *Log) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Log) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *Log) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Log) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return ""
+}
+
+func (m *Log) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Log) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *Log) GetTransactionIndex() string {
+	if m != nil {
+		return m.TransactionIndex
+	}
+	return ""
+}
+
+func (m *Log) GetLogIndex() string {
+	if m != nil {
+		return m.LogIndex
+	}
+	return ""
+}
+
+func (m *Log) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+type Receipt struct {
+	TransactionHash      string           `protobuf:"bytes,1,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	TransactionIndex     string           `protobuf:"bytes,2,opt,name=transactionIndex,proto3" json:"transactionIndex,omitempty"`
+	BlockHash            string           `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockNumber          string           `protobuf:"bytes,4,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	From                 string           `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string           `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	CumulativeGasUsed    string           `protobuf:"bytes,7,opt,name=cumulativeGasUsed,proto3" json:"cumulativeGasUsed,omitempty"`
+	GasUsed              string           `protobuf:"bytes,8,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	EffectiveGasPrice    string           `protobuf:"bytes,9,opt,name=effectiveGasPrice,proto3" json:"effectiveGasPrice,omitempty"`
+	ContractAddress      string           `protobuf:"bytes,10,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Logs                 []*Log           `protobuf:"bytes,11,rep,name=logs,proto3" json:"logs,omitempty"`
+	LogsBloom            string           `protobuf:"bytes,12,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
+	Status               string           `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	Root                 string           `protobuf:"bytes,14,opt,name=root,proto3" json:"root,omitempty"`
+	Type                 string           `protobuf:"bytes,15,opt,name=type,proto3" json:"type,omitempty"`
+	BlobGasUsed          string           `protobuf:"bytes,16,opt,name=blobGasUsed,proto3" json:"blobGasUsed,omitempty"`
+	BlobGasPrice         string           `protobuf:"bytes,17,opt,name=blobGasPrice,proto3" json:"blobGasPrice,omitempty"`
+	TokenMovements       []*TokenMovement `protobuf:"bytes,18,rep,name=tokenMovements,proto3" json:"tokenMovements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{11}
+}
+
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Receipt.Unmarshal(m, b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return xxx_messageInfo_Receipt.Size(m)
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *Receipt) GetTransactionIndex() string {
+	if m != nil {
+		return m.TransactionIndex
+	}
+	return ""
+}
+
+func (m *Receipt) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Receipt) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return ""
+}
+
+func (m *Receipt) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *Receipt) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *Receipt) GetCumulativeGasUsed() string {
+	if m != nil {
+		return m.CumulativeGasUsed
+	}
+	return ""
+}
+
+func (m *Receipt) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *Receipt) GetEffectiveGasPrice() string {
+	if m != nil {
+		return m.EffectiveGasPrice
+	}
+	return ""
+}
+
+func (m *Receipt) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Receipt) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *Receipt) GetLogsBloom() string {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return ""
+}
+
+func (m *Receipt) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Receipt) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *Receipt) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Receipt) GetBlobGasUsed() string {
+	if m != nil {
+		return m.BlobGasUsed
+	}
+	return ""
+}
+
+func (m *Receipt) GetBlobGasPrice() string {
+	if m != nil {
+		return m.BlobGasPrice
+	}
+	return ""
+}
+
+func (m *Receipt) GetTokenMovements() []*TokenMovement {
+	if m != nil {
+		return m.TokenMovements
+	}
+	return nil
+}
+
+type GetTransactionRequest struct {
+	TransactionHash string `protobuf:"bytes,1,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	// withReceipt adds the receipt, left empty while the transaction is
+	// pending.
+	WithReceipt          bool     `protobuf:"varint,2,opt,name=withReceipt,proto3" json:"withReceipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransactionRequest) Reset()         { *m = GetTransactionRequest{} }
+func (m *GetTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionRequest) ProtoMessage()    {}
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{12}
+}
+
+func (m *GetTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionRequest.Unmarshal(m, b)
+}
+func (m *GetTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionRequest.Merge(m, src)
+}
+func (m *GetTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionRequest.Size(m)
+}
+func (m *GetTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionRequest proto.InternalMessageInfo
+
+func (m *GetTransactionRequest) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *GetTransactionRequest) GetWithReceipt() bool {
+	if m != nil {
+		return m.WithReceipt
+	}
+	return false
+}
+
+type GetTransactionResponse struct {
+	Status               string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string       `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Transaction          *Transaction `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Receipt              *Receipt     `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetTransactionResponse) Reset()         { *m = GetTransactionResponse{} }
+func (m *GetTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionResponse) ProtoMessage()    {}
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{13}
+}
+
+func (m *GetTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionResponse.Unmarshal(m, b)
+}
+func (m *GetTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionResponse.Merge(m, src)
+}
+func (m *GetTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionResponse.Size(m)
+}
+func (m *GetTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionResponse proto.InternalMessageInfo
+
+func (m *GetTransactionResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetTransactionResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *GetTransactionResponse) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *GetTransactionResponse) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+type GetTransactionReceiptRequest struct {
+	TransactionHash      string   `protobuf:"bytes,1,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransactionReceiptRequest) Reset()         { *m = GetTransactionReceiptRequest{} }
+func (m *GetTransactionReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionReceiptRequest) ProtoMessage()    {}
+func (*GetTransactionReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{14}
+}
+
+func (m *GetTransactionReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionReceiptRequest.Unmarshal(m, b)
+}
+func (m *GetTransactionReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionReceiptRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionReceiptRequest.Merge(m, src)
+}
+func (m *GetTransactionReceiptRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionReceiptRequest.Size(m)
+}
+func (m *GetTransactionReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionReceiptRequest proto.InternalMessageInfo
+
+func (m *GetTransactionReceiptRequest) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+type GetTransactionReceiptResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Receipt              *Receipt `protobuf:"bytes,3,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransactionReceiptResponse) Reset()         { *m = GetTransactionReceiptResponse{} }
+func (m *GetTransactionReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionReceiptResponse) ProtoMessage()    {}
+func (*GetTransactionReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{15}
+}
+
+func (m *GetTransactionReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionReceiptResponse.Unmarshal(m, b)
+}
+func (m *GetTransactionReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionReceiptResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionReceiptResponse.Merge(m, src)
+}
+func (m *GetTransactionReceiptResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionReceiptResponse.Size(m)
+}
+func (m *GetTransactionReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionReceiptResponse proto.InternalMessageInfo
+
+func (m *GetTransactionReceiptResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetTransactionReceiptResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *GetTransactionReceiptResponse) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+type GetAccountStateRequest struct {
+	Kind                 AccountStateKind `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.AccountStateKind" json:"kind,omitempty"`
+	Addresses            []string         `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Slot                 string           `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Block                string           `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetAccountStateRequest) Reset()         { *m = GetAccountStateRequest{} }
+func (m *GetAccountStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountStateRequest) ProtoMessage()    {}
+func (*GetAccountStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{16}
+}
+
+func (m *GetAccountStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountStateRequest.Unmarshal(m, b)
+}
+func (m *GetAccountStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountStateRequest.Merge(m, src)
+}
+func (m *GetAccountStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountStateRequest.Size(m)
+}
+func (m *GetAccountStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountStateRequest proto.InternalMessageInfo
+
+func (m *GetAccountStateRequest) GetKind() AccountStateKind {
+	if m != nil {
+		return m.Kind
+	}
+	return AccountStateKind_BALANCE
+}
+
+func (m *GetAccountStateRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *GetAccountStateRequest) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+func (m *GetAccountStateRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+type AccountValue struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Block                string   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Slot                 string   `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountValue) Reset()         { *m = AccountValue{} }
+func (m *AccountValue) String() string { return proto.CompactTextString(m) }
+func (*AccountValue) ProtoMessage()    {}
+func (*AccountValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{17}
+}
+
+func (m *AccountValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountValue.Unmarshal(m, b)
+}
+func (m *AccountValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountValue.Marshal(b, m, deterministic)
+}
+func (m *AccountValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountValue.Merge(m, src)
+}
+func (m *AccountValue) XXX_Size() int {
+	return xxx_messageInfo_AccountValue.Size(m)
+}
+func (m *AccountValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountValue proto.InternalMessageInfo
+
+func (m *AccountValue) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountValue) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *AccountValue) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+func (m *AccountValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type GetAccountStateResponse struct {
+	Status               string          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string          `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Values               []*AccountValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetAccountStateResponse) Reset()         { *m = GetAccountStateResponse{} }
+func (m *GetAccountStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountStateResponse) ProtoMessage()    {}
+func (*GetAccountStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{18}
+}
+
+func (m *GetAccountStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountStateResponse.Unmarshal(m, b)
+}
+func (m *GetAccountStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountStateResponse.Merge(m, src)
+}
+func (m *GetAccountStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountStateResponse.Size(m)
+}
+func (m *GetAccountStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountStateResponse proto.InternalMessageInfo
+
+func (m *GetAccountStateResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetAccountStateResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *GetAccountStateResponse) GetValues() []*AccountValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type LogTopics struct {
+	Alternatives         []string `protobuf:"bytes,1,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogTopics) Reset()         { *m = LogTopics{} }
+func (m *LogTopics) String() string { return proto.CompactTextString(m) }
+func (*LogTopics) ProtoMessage()    {}
+func (*LogTopics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{19}
+}
+
+func (m *LogTopics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogTopics.Unmarshal(m, b)
+}
+func (m *LogTopics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogTopics.Marshal(b, m, deterministic)
+}
+func (m *LogTopics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogTopics.Merge(m, src)
+}
+func (m *LogTopics) XXX_Size() int {
+	return xxx_messageInfo_LogTopics.Size(m)
+}
+func (m *LogTopics) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogTopics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogTopics proto.InternalMessageInfo
+
+func (m *LogTopics) GetAlternatives() []string {
+	if m != nil {
+		return m.Alternatives
+	}
+	return nil
+}
+
+type GetLogsRequest struct {
+	Addresses            []string     `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Topics               []*LogTopics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	FromBlock            string       `protobuf:"bytes,3,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock              string       `protobuf:"bytes,4,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	BlockHash            string       `protobuf:"bytes,5,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Cursor               string       `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                uint32       `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
+func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLogsRequest) ProtoMessage()    {}
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{20}
+}
+
+func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsRequest.Unmarshal(m, b)
+}
+func (m *GetLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsRequest.Merge(m, src)
+}
+func (m *GetLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLogsRequest.Size(m)
+}
+func (m *GetLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsRequest proto.InternalMessageInfo
+
+func (m *GetLogsRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetTopics() []*LogTopics {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetFromBlock() string {
+	if m != nil {
+		return m.FromBlock
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetToBlock() string {
+	if m != nil {
+		return m.ToBlock
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetLogsResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Logs                 []*Log   `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	FromBlock            string   `protobuf:"bytes,4,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock              string   `protobuf:"bytes,5,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	NextCursor           string   `protobuf:"bytes,6,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLogsResponse) Reset()         { *m = GetLogsResponse{} }
+func (m *GetLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLogsResponse) ProtoMessage()    {}
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{21}
+}
+
+func (m *GetLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsResponse.Unmarshal(m, b)
+}
+func (m *GetLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsResponse.Merge(m, src)
+}
+func (m *GetLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLogsResponse.Size(m)
+}
+func (m *GetLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsResponse proto.InternalMessageInfo
+
+func (m *GetLogsResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetLogsResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *GetLogsResponse) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *GetLogsResponse) GetFromBlock() string {
+	if m != nil {
+		return m.FromBlock
+	}
+	return ""
+}
+
+func (m *GetLogsResponse) GetToBlock() string {
+	if m != nil {
+		return m.ToBlock
+	}
+	return ""
+}
+
+func (m *GetLogsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+// CallContractRequest takes the ABI as JSON in abi, or the name of a
+// registered ABI in abiName, and the arguments as a JSON array in args.
+type CallContractRequest struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Abi                  string   `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+	AbiName              string   `protobuf:"bytes,3,opt,name=abiName,proto3" json:"abiName,omitempty"`
+	Function             string   `protobuf:"bytes,4,opt,name=function,proto3" json:"function,omitempty"`
+	Args                 string   `protobuf:"bytes,5,opt,name=args,proto3" json:"args,omitempty"`
+	From                 string   `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	Block                string   `protobuf:"bytes,7,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallContractRequest) Reset()         { *m = CallContractRequest{} }
+func (m *CallContractRequest) String() string { return proto.CompactTextString(m) }
+func (*CallContractRequest) ProtoMessage()    {}
+func (*CallContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{22}
+}
+
+func (m *CallContractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallContractRequest.Unmarshal(m, b)
+}
+func (m *CallContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallContractRequest.Marshal(b, m, deterministic)
+}
+func (m *CallContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallContractRequest.Merge(m, src)
+}
+func (m *CallContractRequest) XXX_Size() int {
+	return xxx_messageInfo_CallContractRequest.Size(m)
+}
+func (m *CallContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallContractRequest proto.InternalMessageInfo
+
+func (m *CallContractRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetAbiName() string {
+	if m != nil {
+		return m.AbiName
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CallContractRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+// CallContractResponse carries the decoded outputs as JSON.
+type CallContractResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Contract             string   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Function             string   `protobuf:"bytes,4,opt,name=function,proto3" json:"function,omitempty"`
+	Block                string   `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
+	Outputs              string   `protobuf:"bytes,6,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	ReturnData           string   `protobuf:"bytes,7,opt,name=returnData,proto3" json:"returnData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallContractResponse) Reset()         { *m = CallContractResponse{} }
+func (m *CallContractResponse) String() string { return proto.CompactTextString(m) }
+func (*CallContractResponse) ProtoMessage()    {}
+func (*CallContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{23}
+}
+
+func (m *CallContractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallContractResponse.Unmarshal(m, b)
+}
+func (m *CallContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallContractResponse.Marshal(b, m, deterministic)
+}
+func (m *CallContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallContractResponse.Merge(m, src)
+}
+func (m *CallContractResponse) XXX_Size() int {
+	return xxx_messageInfo_CallContractResponse.Size(m)
+}
+func (m *CallContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallContractResponse proto.InternalMessageInfo
+
+func (m *CallContractResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *CallContractResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *CallContractResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *CallContractResponse) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *CallContractResponse) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *CallContractResponse) GetOutputs() string {
+	if m != nil {
+		return m.Outputs
+	}
+	return ""
+}
+
+func (m *CallContractResponse) GetReturnData() string {
+	if m != nil {
+		return m.ReturnData
+	}
+	return ""
+}
+
+type SendRawTransactionRequest struct {
+	RawTransaction       string   `protobuf:"bytes,1,opt,name=rawTransaction,proto3" json:"rawTransaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendRawTransactionRequest) Reset()         { *m = SendRawTransactionRequest{} }
+func (m *SendRawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SendRawTransactionRequest) ProtoMessage()    {}
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{24}
+}
+
+func (m *SendRawTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendRawTransactionRequest.Unmarshal(m, b)
+}
+func (m *SendRawTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendRawTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SendRawTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRawTransactionRequest.Merge(m, src)
+}
+func (m *SendRawTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SendRawTransactionRequest.Size(m)
+}
+func (m *SendRawTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRawTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRawTransactionRequest proto.InternalMessageInfo
+
+func (m *SendRawTransactionRequest) GetRawTransaction() string {
+	if m != nil {
+		return m.RawTransaction
+	}
+	return ""
+}
+
+type AccessTuple struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StorageKeys          []string `protobuf:"bytes,2,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
+func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
+func (*AccessTuple) ProtoMessage()    {}
+func (*AccessTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{25}
+}
+
+func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessTuple.Unmarshal(m, b)
+}
+func (m *AccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessTuple.Marshal(b, m, deterministic)
+}
+func (m *AccessTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessTuple.Merge(m, src)
+}
+func (m *AccessTuple) XXX_Size() int {
+	return xxx_messageInfo_AccessTuple.Size(m)
+}
+func (m *AccessTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessTuple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
+
+func (m *AccessTuple) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccessTuple) GetStorageKeys() []string {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
+type Authorization struct {
+	ChainId              string   `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce                string   `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	YParity              string   `protobuf:"bytes,4,opt,name=yParity,proto3" json:"yParity,omitempty"`
+	R                    string   `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	S                    string   `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+	Authority            string   `protobuf:"bytes,7,opt,name=authority,proto3" json:"authority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Authorization) Reset()         { *m = Authorization{} }
+func (m *Authorization) String() string { return proto.CompactTextString(m) }
+func (*Authorization) ProtoMessage()    {}
+func (*Authorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{26}
+}
+
+func (m *Authorization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Authorization.Unmarshal(m, b)
+}
+func (m *Authorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Authorization.Marshal(b, m, deterministic)
+}
+func (m *Authorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authorization.Merge(m, src)
+}
+func (m *Authorization) XXX_Size() int {
+	return xxx_messageInfo_Authorization.Size(m)
+}
+func (m *Authorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authorization proto.InternalMessageInfo
+
+func (m *Authorization) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Authorization) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Authorization) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *Authorization) GetYParity() string {
+	if m != nil {
+		return m.YParity
+	}
+	return ""
+}
+
+func (m *Authorization) GetR() string {
+	if m != nil {
+		return m.R
+	}
+	return ""
+}
+
+func (m *Authorization) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *Authorization) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// DecodedTransaction is a raw transaction decoded before it was sent, with
+// the sender recovered from its signature.
+type DecodedTransaction struct {
+	Type                 string           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Hash                 string           `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	From                 string           `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	ChainId              string           `protobuf:"bytes,4,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Nonce                string           `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasPrice             string           `protobuf:"bytes,6,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	MaxPriorityFeePerGas string           `protobuf:"bytes,7,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerGas         string           `protobuf:"bytes,8,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
+	Gas                  string           `protobuf:"bytes,9,opt,name=gas,proto3" json:"gas,omitempty"`
+	To                   string           `protobuf:"bytes,10,opt,name=to,proto3" json:"to,omitempty"`
+	Value                string           `protobuf:"bytes,11,opt,name=value,proto3" json:"value,omitempty"`
+	Input                string           `protobuf:"bytes,12,opt,name=input,proto3" json:"input,omitempty"`
+	AccessList           []*AccessTuple   `protobuf:"bytes,13,rep,name=accessList,proto3" json:"accessList,omitempty"`
+	MaxFeePerBlobGas     string           `protobuf:"bytes,14,opt,name=maxFeePerBlobGas,proto3" json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []string         `protobuf:"bytes,15,rep,name=blobVersionedHashes,proto3" json:"blobVersionedHashes,omitempty"`
+	AuthorizationList    []*Authorization `protobuf:"bytes,16,rep,name=authorizationList,proto3" json:"authorizationList,omitempty"`
+	V                    string           `protobuf:"bytes,17,opt,name=v,proto3" json:"v,omitempty"`
+	R                    string           `protobuf:"bytes,18,opt,name=r,proto3" json:"r,omitempty"`
+	S                    string           `protobuf:"bytes,19,opt,name=s,proto3" json:"s,omitempty"`
+	YParity              string           `protobuf:"bytes,20,opt,name=yParity,proto3" json:"yParity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DecodedTransaction) Reset()         { *m = DecodedTransaction{} }
+func (m *DecodedTransaction) String() string { return proto.CompactTextString(m) }
+func (*DecodedTransaction) ProtoMessage()    {}
+func (*DecodedTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{27}
+}
+
+func (m *DecodedTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodedTransaction.Unmarshal(m, b)
+}
+func (m *DecodedTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodedTransaction.Marshal(b, m, deterministic)
+}
+func (m *DecodedTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedTransaction.Merge(m, src)
+}
+func (m *DecodedTransaction) XXX_Size() int {
+	return xxx_messageInfo_DecodedTransaction.Size(m)
+}
+func (m *DecodedTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedTransaction proto.InternalMessageInfo
+
+func (m *DecodedTransaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetMaxPriorityFeePerGas() string {
+	if m != nil {
+		return m.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetMaxFeePerGas() string {
+	if m != nil {
+		return m.MaxFeePerGas
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetGas() string {
+	if m != nil {
+		return m.Gas
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetAccessList() []*AccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *DecodedTransaction) GetMaxFeePerBlobGas() string {
+	if m != nil {
+		return m.MaxFeePerBlobGas
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetBlobVersionedHashes() []string {
+	if m != nil {
+		return m.BlobVersionedHashes
+	}
+	return nil
+}
+
+func (m *DecodedTransaction) GetAuthorizationList() []*Authorization {
+	if m != nil {
+		return m.AuthorizationList
+	}
+	return nil
+}
+
+func (m *DecodedTransaction) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetR() string {
+	if m != nil {
+		return m.R
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *DecodedTransaction) GetYParity() string {
+	if m != nil {
+		return m.YParity
+	}
+	return ""
+}
+
+type SendRawTransactionResponse struct {
+	Status               string              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string              `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Hash                 string              `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Transaction          *DecodedTransaction `protobuf:"bytes,4,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SendRawTransactionResponse) Reset()         { *m = SendRawTransactionResponse{} }
+func (m *SendRawTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SendRawTransactionResponse) ProtoMessage()    {}
+func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{28}
+}
+
+func (m *SendRawTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendRawTransactionResponse.Unmarshal(m, b)
+}
+func (m *SendRawTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendRawTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *SendRawTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRawTransactionResponse.Merge(m, src)
+}
+func (m *SendRawTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SendRawTransactionResponse.Size(m)
+}
+func (m *SendRawTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRawTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRawTransactionResponse proto.InternalMessageInfo
+
+func (m *SendRawTransactionResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SendRawTransactionResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *SendRawTransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SendRawTransactionResponse) GetTransaction() *DecodedTransaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+// TransactionRejection is attached to the status details of a rejected
+// SendRawTransaction call.
+type TransactionRejection struct {
+	Reason               string            `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Details              map[string]string `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TransactionRejection) Reset()         { *m = TransactionRejection{} }
+func (m *TransactionRejection) String() string { return proto.CompactTextString(m) }
+func (*TransactionRejection) ProtoMessage()    {}
+func (*TransactionRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{29}
+}
+
+func (m *TransactionRejection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionRejection.Unmarshal(m, b)
+}
+func (m *TransactionRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionRejection.Marshal(b, m, deterministic)
+}
+func (m *TransactionRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionRejection.Merge(m, src)
+}
+func (m *TransactionRejection) XXX_Size() int {
+	return xxx_messageInfo_TransactionRejection.Size(m)
+}
+func (m *TransactionRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionRejection proto.InternalMessageInfo
+
+func (m *TransactionRejection) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TransactionRejection) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *TransactionRejection) GetDetails() map[string]string {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+// CallArgs is a transaction to call or estimate, its quantities in hex.
+type CallArgs struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Gas                  string   `protobuf:"bytes,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPrice             string   `protobuf:"bytes,4,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	MaxFeePerGas         string   `protobuf:"bytes,5,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string   `protobuf:"bytes,6,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
+	Value                string   `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	Data                 string   `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallArgs) Reset()         { *m = CallArgs{} }
+func (m *CallArgs) String() string { return proto.CompactTextString(m) }
+func (*CallArgs) ProtoMessage()    {}
+func (*CallArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{30}
+}
+
+func (m *CallArgs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallArgs.Unmarshal(m, b)
+}
+func (m *CallArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallArgs.Marshal(b, m, deterministic)
+}
+func (m *CallArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallArgs.Merge(m, src)
+}
+func (m *CallArgs) XXX_Size() int {
+	return xxx_messageInfo_CallArgs.Size(m)
+}
+func (m *CallArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallArgs proto.InternalMessageInfo
+
+func (m *CallArgs) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CallArgs) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CallArgs) GetGas() string {
+	if m != nil {
+		return m.Gas
+	}
+	return ""
+}
+
+func (m *CallArgs) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *CallArgs) GetMaxFeePerGas() string {
+	if m != nil {
+		return m.MaxFeePerGas
+	}
+	return ""
+}
+
+func (m *CallArgs) GetMaxPriorityFeePerGas() string {
+	if m != nil {
+		return m.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (m *CallArgs) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *CallArgs) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// SuggestFeesRequest estimates the gas of transaction when set.
+type SuggestFeesRequest struct {
+	Transaction          *CallArgs `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SuggestFeesRequest) Reset()         { *m = SuggestFeesRequest{} }
+func (m *SuggestFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*SuggestFeesRequest) ProtoMessage()    {}
+func (*SuggestFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{31}
+}
+
+func (m *SuggestFeesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestFeesRequest.Unmarshal(m, b)
+}
+func (m *SuggestFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestFeesRequest.Marshal(b, m, deterministic)
+}
+func (m *SuggestFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestFeesRequest.Merge(m, src)
+}
+func (m *SuggestFeesRequest) XXX_Size() int {
+	return xxx_messageInfo_SuggestFeesRequest.Size(m)
+}
+func (m *SuggestFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestFeesRequest proto.InternalMessageInfo
+
+func (m *SuggestFeesRequest) GetTransaction() *CallArgs {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type FeeTier struct {
+	MaxFeePerGas         string   `protobuf:"bytes,1,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string   `protobuf:"bytes,2,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{32}
+}
+
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeTier.Unmarshal(m, b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return xxx_messageInfo_FeeTier.Size(m)
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
+func (m *FeeTier) GetMaxFeePerGas() string {
+	if m != nil {
+		return m.MaxFeePerGas
+	}
+	return ""
+}
+
+func (m *FeeTier) GetMaxPriorityFeePerGas() string {
+	if m != nil {
+		return m.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+type SuggestFeesResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	BaseFeePerGas        string   `protobuf:"bytes,3,opt,name=baseFeePerGas,proto3" json:"baseFeePerGas,omitempty"`
+	NewestBlock          string   `protobuf:"bytes,4,opt,name=newestBlock,proto3" json:"newestBlock,omitempty"`
+	Slow                 *FeeTier `protobuf:"bytes,5,opt,name=slow,proto3" json:"slow,omitempty"`
+	Standard             *FeeTier `protobuf:"bytes,6,opt,name=standard,proto3" json:"standard,omitempty"`
+	Fast                 *FeeTier `protobuf:"bytes,7,opt,name=fast,proto3" json:"fast,omitempty"`
+	GasEstimate          string   `protobuf:"bytes,8,opt,name=gasEstimate,proto3" json:"gasEstimate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestFeesResponse) Reset()         { *m = SuggestFeesResponse{} }
+func (m *SuggestFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*SuggestFeesResponse) ProtoMessage()    {}
+func (*SuggestFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{33}
+}
+
+func (m *SuggestFeesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestFeesResponse.Unmarshal(m, b)
+}
+func (m *SuggestFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestFeesResponse.Marshal(b, m, deterministic)
+}
+func (m *SuggestFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestFeesResponse.Merge(m, src)
+}
+func (m *SuggestFeesResponse) XXX_Size() int {
+	return xxx_messageInfo_SuggestFeesResponse.Size(m)
+}
+func (m *SuggestFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestFeesResponse proto.InternalMessageInfo
+
+func (m *SuggestFeesResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SuggestFeesResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *SuggestFeesResponse) GetBaseFeePerGas() string {
+	if m != nil {
+		return m.BaseFeePerGas
+	}
+	return ""
+}
+
+func (m *SuggestFeesResponse) GetNewestBlock() string {
+	if m != nil {
+		return m.NewestBlock
+	}
+	return ""
+}
+
+func (m *SuggestFeesResponse) GetSlow() *FeeTier {
+	if m != nil {
+		return m.Slow
+	}
+	return nil
+}
+
+func (m *SuggestFeesResponse) GetStandard() *FeeTier {
+	if m != nil {
+		return m.Standard
+	}
+	return nil
+}
+
+func (m *SuggestFeesResponse) GetFast() *FeeTier {
+	if m != nil {
+		return m.Fast
+	}
+	return nil
+}
+
+func (m *SuggestFeesResponse) GetGasEstimate() string {
+	if m != nil {
+		return m.GasEstimate
+	}
+	return ""
+}
+
+// TraceTransactionRequest needs a debug token in x-debug-token metadata.
+type TraceTransactionRequest struct {
+	TransactionHash      string   `protobuf:"bytes,1,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	Prestate             bool     `protobuf:"varint,2,opt,name=prestate,proto3" json:"prestate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceTransactionRequest) Reset()         { *m = TraceTransactionRequest{} }
+func (m *TraceTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceTransactionRequest) ProtoMessage()    {}
+func (*TraceTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{34}
+}
+
+func (m *TraceTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceTransactionRequest.Unmarshal(m, b)
+}
+func (m *TraceTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *TraceTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceTransactionRequest.Merge(m, src)
+}
+func (m *TraceTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_TraceTransactionRequest.Size(m)
+}
+func (m *TraceTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceTransactionRequest proto.InternalMessageInfo
+
+func (m *TraceTransactionRequest) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *TraceTransactionRequest) GetPrestate() bool {
+	if m != nil {
+		return m.Prestate
+	}
+	return false
+}
+
+type CallFrame struct {
+	Type                 string       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From                 string       `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string       `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                string       `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas                  string       `protobuf:"bytes,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed              string       `protobuf:"bytes,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input                string       `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output               string       `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error                string       `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	RevertReason         string       `protobuf:"bytes,10,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
+	Calls                []*CallFrame `protobuf:"bytes,11,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CallFrame) Reset()         { *m = CallFrame{} }
+func (m *CallFrame) String() string { return proto.CompactTextString(m) }
+func (*CallFrame) ProtoMessage()    {}
+func (*CallFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{35}
+}
+
+func (m *CallFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallFrame.Unmarshal(m, b)
+}
+func (m *CallFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallFrame.Marshal(b, m, deterministic)
+}
+func (m *CallFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallFrame.Merge(m, src)
+}
+func (m *CallFrame) XXX_Size() int {
+	return xxx_messageInfo_CallFrame.Size(m)
+}
+func (m *CallFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallFrame proto.InternalMessageInfo
+
+func (m *CallFrame) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CallFrame) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CallFrame) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CallFrame) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *CallFrame) GetGas() string {
+	if m != nil {
+		return m.Gas
+	}
+	return ""
+}
+
+func (m *CallFrame) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *CallFrame) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+func (m *CallFrame) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func (m *CallFrame) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CallFrame) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (m *CallFrame) GetCalls() []*CallFrame {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+type ValueTransfer struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	TraceAddress         []uint32 `protobuf:"varint,5,rep,packed,name=traceAddress,proto3" json:"traceAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValueTransfer) Reset()         { *m = ValueTransfer{} }
+func (m *ValueTransfer) String() string { return proto.CompactTextString(m) }
+func (*ValueTransfer) ProtoMessage()    {}
+func (*ValueTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{36}
+}
+
+func (m *ValueTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueTransfer.Unmarshal(m, b)
+}
+func (m *ValueTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueTransfer.Marshal(b, m, deterministic)
+}
+func (m *ValueTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueTransfer.Merge(m, src)
+}
+func (m *ValueTransfer) XXX_Size() int {
+	return xxx_messageInfo_ValueTransfer.Size(m)
+}
+func (m *ValueTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueTransfer proto.InternalMessageInfo
+
+func (m *ValueTransfer) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ValueTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ValueTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ValueTransfer) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ValueTransfer) GetTraceAddress() []uint32 {
+	if m != nil {
+		return m.TraceAddress
+	}
+	return nil
+}
+
+type PrestateAccount struct {
+	Balance              string            `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce                uint64            `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Code                 string            `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Storage              map[string]string `protobuf:"bytes,4,rep,name=storage,proto3" json:"storage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PrestateAccount) Reset()         { *m = PrestateAccount{} }
+func (m *PrestateAccount) String() string { return proto.CompactTextString(m) }
+func (*PrestateAccount) ProtoMessage()    {}
+func (*PrestateAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{37}
+}
+
+func (m *PrestateAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrestateAccount.Unmarshal(m, b)
+}
+func (m *PrestateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrestateAccount.Marshal(b, m, deterministic)
+}
+func (m *PrestateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrestateAccount.Merge(m, src)
+}
+func (m *PrestateAccount) XXX_Size() int {
+	return xxx_messageInfo_PrestateAccount.Size(m)
+}
+func (m *PrestateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrestateAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrestateAccount proto.InternalMessageInfo
+
+func (m *PrestateAccount) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *PrestateAccount) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PrestateAccount) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *PrestateAccount) GetStorage() map[string]string {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+type TraceTransactionResponse struct {
+	Status               string                      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string                      `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	TransactionHash      string                      `protobuf:"bytes,3,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	Call                 *CallFrame                  `protobuf:"bytes,4,opt,name=call,proto3" json:"call,omitempty"`
+	Transfers            []*ValueTransfer            `protobuf:"bytes,5,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Prestate             map[string]*PrestateAccount `protobuf:"bytes,6,rep,name=prestate,proto3" json:"prestate,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *TraceTransactionResponse) Reset()         { *m = TraceTransactionResponse{} }
+func (m *TraceTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*TraceTransactionResponse) ProtoMessage()    {}
+func (*TraceTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{38}
+}
+
+func (m *TraceTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceTransactionResponse.Unmarshal(m, b)
+}
+func (m *TraceTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *TraceTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceTransactionResponse.Merge(m, src)
+}
+func (m *TraceTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_TraceTransactionResponse.Size(m)
+}
+func (m *TraceTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceTransactionResponse proto.InternalMessageInfo
+
+func (m *TraceTransactionResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetCall() *CallFrame {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *TraceTransactionResponse) GetTransfers() []*ValueTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *TraceTransactionResponse) GetPrestate() map[string]*PrestateAccount {
+	if m != nil {
+		return m.Prestate
+	}
+	return nil
+}
+
+// TokenMovement is an ERC-20 transfer or approval. decimals is only
+// meaningful when displayAmount is set.
+type TokenMovement struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	From                 string   `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Spender              string   `protobuf:"bytes,6,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount               string   `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	DisplayAmount        string   `protobuf:"bytes,8,opt,name=displayAmount,proto3" json:"displayAmount,omitempty"`
+	Symbol               string   `protobuf:"bytes,9,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals             uint32   `protobuf:"varint,10,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	LogIndex             string   `protobuf:"bytes,11,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenMovement) Reset()         { *m = TokenMovement{} }
+func (m *TokenMovement) String() string { return proto.CompactTextString(m) }
+func (*TokenMovement) ProtoMessage()    {}
+func (*TokenMovement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{39}
+}
+
+func (m *TokenMovement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenMovement.Unmarshal(m, b)
+}
+func (m *TokenMovement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenMovement.Marshal(b, m, deterministic)
+}
+func (m *TokenMovement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMovement.Merge(m, src)
+}
+func (m *TokenMovement) XXX_Size() int {
+	return xxx_messageInfo_TokenMovement.Size(m)
+}
+func (m *TokenMovement) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMovement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMovement proto.InternalMessageInfo
+
+func (m *TokenMovement) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetDisplayAmount() string {
+	if m != nil {
+		return m.DisplayAmount
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenMovement) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *TokenMovement) GetLogIndex() string {
+	if m != nil {
+		return m.LogIndex
+	}
+	return ""
+}
+
+type GetTokenBalanceRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Block                string   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{} }
+func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTokenBalanceRequest) ProtoMessage()    {}
+func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{40}
+}
+
+func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTokenBalanceRequest.Unmarshal(m, b)
+}
+func (m *GetTokenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTokenBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTokenBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenBalanceRequest.Merge(m, src)
+}
+func (m *GetTokenBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTokenBalanceRequest.Size(m)
+}
+func (m *GetTokenBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenBalanceRequest proto.InternalMessageInfo
+
+func (m *GetTokenBalanceRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+// GetTokenBalanceResponse renders the balance in whole tokens as
+// displayBalance when the token reports its decimals.
+type GetTokenBalanceResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Address              string   `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Block                string   `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
+	Balance              string   `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	DisplayBalance       string   `protobuf:"bytes,7,opt,name=displayBalance,proto3" json:"displayBalance,omitempty"`
+	Name                 string   `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol               string   `protobuf:"bytes,9,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals             uint32   `protobuf:"varint,10,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse{} }
+func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTokenBalanceResponse) ProtoMessage()    {}
+func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b58a0e0835cfa32, []int{41}
+}
+
+func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTokenBalanceResponse.Unmarshal(m, b)
+}
+func (m *GetTokenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTokenBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTokenBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenBalanceResponse.Merge(m, src)
+}
+func (m *GetTokenBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTokenBalanceResponse.Size(m)
+}
+func (m *GetTokenBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenBalanceResponse proto.InternalMessageInfo
+
+func (m *GetTokenBalanceResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetDisplayBalance() string {
+	if m != nil {
+		return m.DisplayBalance
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("proto.AccountStateKind", AccountStateKind_name, AccountStateKind_value)
+	proto.RegisterType((*GetSyncRequest)(nil), "proto.GetSyncRequest")
+	proto.RegisterType((*SyncInfo)(nil), "proto.SyncInfo")
+	proto.RegisterType((*GetSyncResponse)(nil), "proto.GetSyncResponse")
+	proto.RegisterType((*GetTxsForBlockHashRequest)(nil), "proto.GetTxsForBlockHashRequest")
+	proto.RegisterType((*Transaction)(nil), "proto.Transaction")
+	proto.RegisterType((*GetTxsForBlockHashResponse)(nil), "proto.GetTxsForBlockHashResponse")
+	proto.RegisterType((*GetBlockRequest)(nil), "proto.GetBlockRequest")
+	proto.RegisterType((*Withdrawal)(nil), "proto.Withdrawal")
+	proto.RegisterType((*Block)(nil), "proto.Block")
+	proto.RegisterType((*GetBlockResponse)(nil), "proto.GetBlockResponse")
+	proto.RegisterType((*Log)(nil), "proto.Log")
+	proto.RegisterType((*Receipt)(nil), "proto.Receipt")
+	proto.RegisterType((*GetTransactionRequest)(nil), "proto.GetTransactionRequest")
+	proto.RegisterType((*GetTransactionResponse)(nil), "proto.GetTransactionResponse")
+	proto.RegisterType((*GetTransactionReceiptRequest)(nil), "proto.GetTransactionReceiptRequest")
+	proto.RegisterType((*GetTransactionReceiptResponse)(nil), "proto.GetTransactionReceiptResponse")
+	proto.RegisterType((*GetAccountStateRequest)(nil), "proto.GetAccountStateRequest")
+	proto.RegisterType((*AccountValue)(nil), "proto.AccountValue")
+	proto.RegisterType((*GetAccountStateResponse)(nil), "proto.GetAccountStateResponse")
+	proto.RegisterType((*LogTopics)(nil), "proto.LogTopics")
+	proto.RegisterType((*GetLogsRequest)(nil), "proto.GetLogsRequest")
+	proto.RegisterType((*GetLogsResponse)(nil), "proto.GetLogsResponse")
+	proto.RegisterType((*CallContractRequest)(nil), "proto.CallContractRequest")
+	proto.RegisterType((*CallContractResponse)(nil), "proto.CallContractResponse")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "proto.SendRawTransactionRequest")
+	proto.RegisterType((*AccessTuple)(nil), "proto.AccessTuple")
+	proto.RegisterType((*Authorization)(nil), "proto.Authorization")
+	proto.RegisterType((*DecodedTransaction)(nil), "proto.DecodedTransaction")
+	proto.RegisterType((*SendRawTransactionResponse)(nil), "proto.SendRawTransactionResponse")
+	proto.RegisterType((*TransactionRejection)(nil), "proto.TransactionRejection")
+	proto.RegisterMapType((map[string]string)(nil), "proto.TransactionRejection.DetailsEntry")
+	proto.RegisterType((*CallArgs)(nil), "proto.CallArgs")
+	proto.RegisterType((*SuggestFeesRequest)(nil), "proto.SuggestFeesRequest")
+	proto.RegisterType((*FeeTier)(nil), "proto.FeeTier")
+	proto.RegisterType((*SuggestFeesResponse)(nil), "proto.SuggestFeesResponse")
+	proto.RegisterType((*TraceTransactionRequest)(nil), "proto.TraceTransactionRequest")
+	proto.RegisterType((*CallFrame)(nil), "proto.CallFrame")
+	proto.RegisterType((*ValueTransfer)(nil), "proto.ValueTransfer")
+	proto.RegisterType((*PrestateAccount)(nil), "proto.PrestateAccount")
+	proto.RegisterMapType((map[string]string)(nil), "proto.PrestateAccount.StorageEntry")
+	proto.RegisterType((*TraceTransactionResponse)(nil), "proto.TraceTransactionResponse")
+	proto.RegisterMapType((map[string]*PrestateAccount)(nil), "proto.TraceTransactionResponse.PrestateEntry")
+	proto.RegisterType((*TokenMovement)(nil), "proto.TokenMovement")
+	proto.RegisterType((*GetTokenBalanceRequest)(nil), "proto.GetTokenBalanceRequest")
+	proto.RegisterType((*GetTokenBalanceResponse)(nil), "proto.GetTokenBalanceResponse")
+}
+
+func init() { proto.RegisterFile("ethgrpc.proto", fileDescriptor_7b58a0e0835cfa32) }
+
+var fileDescriptor_7b58a0e0835cfa32 = []byte{
+	// 2894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xa7, 0xe7, 0xdb, 0x6f, 0xfc, 0x31, 0x2e, 0x7b, 0xbd, 0xbd, 0x93, 0xc4, 0x38, 0x9d, 0x68,
+	0x65, 0x6d, 0x96, 0x25, 0x38, 0x11, 0x0a, 0x09, 0x39, 0xd8, 0xde, 0x5d, 0x67, 0x15, 0xef, 0xae,
+	0xd5, 0x9e, 0x04, 0x71, 0x21, 0xaa, 0xe9, 0x29, 0x8f, 0x9b, 0xed, 0xe9, 0x1e, 0xba, 0x6a, 0xbc,
+	0x76, 0x04, 0x07, 0x90, 0x38, 0x22, 0xb8, 0x00, 0x27, 0x8e, 0x1c, 0x38, 0x20, 0x38, 0x20, 0xf1,
+	0x17, 0x20, 0x71, 0xe4, 0x8c, 0x22, 0x6e, 0xfc, 0x13, 0x9c, 0x50, 0x7d, 0x75, 0x57, 0x75, 0xf7,
+	0x78, 0x77, 0x61, 0x4e, 0xd3, 0xef, 0xd5, 0xeb, 0xaa, 0xf7, 0x5e, 0xbd, 0xf7, 0xab, 0x57, 0xaf,
+	0x07, 0x56, 0x08, 0x3b, 0x1f, 0xa7, 0xd3, 0xe0, 0xde, 0x34, 0x4d, 0x58, 0x82, 0x9a, 0xe2, 0xc7,
+	0xeb, 0xc1, 0xea, 0x11, 0x61, 0xa7, 0x57, 0x71, 0xe0, 0x93, 0x1f, 0xcd, 0x08, 0x65, 0xde, 0x25,
+	0x74, 0x38, 0xf9, 0x28, 0x3e, 0x4b, 0xd0, 0xdb, 0xb0, 0x42, 0x19, 0x4e, 0x59, 0x18, 0x8f, 0x0f,
+	0xa2, 0x24, 0x78, 0xe6, 0x3a, 0x3b, 0xce, 0xee, 0x92, 0x6f, 0x33, 0x91, 0x07, 0xcb, 0xc1, 0x2c,
+	0x4d, 0x49, 0xcc, 0xa4, 0x50, 0x4d, 0x08, 0x59, 0x3c, 0x2e, 0x73, 0x1e, 0x8e, 0xcf, 0x09, 0x55,
+	0x32, 0x75, 0x29, 0x63, 0xf2, 0xbc, 0x2f, 0x61, 0x2d, 0xd3, 0x85, 0x4e, 0x93, 0x98, 0x12, 0xb4,
+	0x05, 0x2d, 0xca, 0x30, 0x9b, 0x51, 0xb5, 0xb2, 0xa2, 0xf8, 0x74, 0x24, 0x4d, 0x93, 0xf4, 0x31,
+	0xa1, 0x14, 0x8f, 0x89, 0x5e, 0xd2, 0xe4, 0xa1, 0x77, 0xa0, 0x43, 0x95, 0x21, 0x62, 0xb9, 0xee,
+	0xde, 0x9a, 0xb4, 0xfd, 0x9e, 0xb6, 0xcf, 0xcf, 0x04, 0xbc, 0xef, 0xc0, 0xad, 0x23, 0xc2, 0x06,
+	0x97, 0xf4, 0x61, 0x92, 0x0a, 0x6d, 0x3e, 0xc1, 0xf4, 0x5c, 0xb9, 0x04, 0xbd, 0x0e, 0x4b, 0x43,
+	0xcd, 0x53, 0x8a, 0xe4, 0x0c, 0xef, 0x97, 0x0d, 0xe8, 0x0e, 0x52, 0x1c, 0x53, 0x1c, 0xb0, 0x30,
+	0x89, 0xaf, 0x97, 0x46, 0x3b, 0xd0, 0x15, 0xc4, 0x93, 0xd9, 0x64, 0x48, 0x52, 0xa5, 0xb8, 0xc9,
+	0x42, 0x08, 0x1a, 0x67, 0x69, 0x32, 0x51, 0x2e, 0x12, 0xcf, 0xa8, 0x07, 0xf5, 0x31, 0xa6, 0x6e,
+	0x43, 0xb0, 0xf8, 0x23, 0xea, 0x43, 0x67, 0x8c, 0xe9, 0x49, 0x1a, 0x06, 0xc4, 0x6d, 0x0a, 0x76,
+	0x46, 0xf3, 0x19, 0xce, 0xf9, 0xe2, 0x2d, 0x39, 0x03, 0x7f, 0x46, 0x9b, 0xd0, 0x0c, 0xe3, 0xe9,
+	0x8c, 0xb9, 0x6d, 0xc1, 0x94, 0x04, 0xe7, 0xc6, 0x49, 0x1c, 0x10, 0xb7, 0x23, 0xb9, 0x82, 0x40,
+	0xab, 0x50, 0x63, 0x89, 0xbb, 0x24, 0x58, 0x35, 0x96, 0xa0, 0x3b, 0xd0, 0x63, 0xb9, 0x81, 0x8f,
+	0xe2, 0x11, 0xb9, 0x74, 0x41, 0x8c, 0x96, 0xf8, 0x7c, 0xc6, 0x0b, 0x1c, 0xcd, 0x88, 0xdb, 0x95,
+	0x33, 0x0a, 0x02, 0x2d, 0x83, 0x73, 0xe1, 0x2e, 0x0b, 0x8e, 0x73, 0xc1, 0xa9, 0xd4, 0x5d, 0x91,
+	0x54, 0xca, 0x29, 0xea, 0xae, 0x4a, 0x8a, 0x72, 0xdd, 0xd9, 0xd5, 0x94, 0xb8, 0x6b, 0x52, 0x77,
+	0xfe, 0x8c, 0x5c, 0x68, 0x07, 0xe7, 0x38, 0x8c, 0x1f, 0x8d, 0xdc, 0x9e, 0x60, 0x6b, 0x92, 0xc7,
+	0xc1, 0x04, 0x5f, 0x3e, 0x24, 0xe4, 0x84, 0xa4, 0x47, 0x98, 0xba, 0xeb, 0x32, 0x0e, 0x4c, 0x1e,
+	0xda, 0x83, 0xcd, 0x09, 0xbe, 0x3c, 0x49, 0xc3, 0x24, 0x0d, 0xd9, 0x55, 0x2e, 0x8b, 0x84, 0x6c,
+	0xe5, 0x18, 0xfa, 0x2e, 0xac, 0xb2, 0xe4, 0x19, 0x89, 0x1f, 0x27, 0x17, 0x64, 0x42, 0x62, 0x46,
+	0xdd, 0x8d, 0x9d, 0xfa, 0x6e, 0x77, 0x6f, 0x53, 0x45, 0xd0, 0xc0, 0x1c, 0xf4, 0x0b, 0xb2, 0xde,
+	0xaf, 0x1c, 0xe8, 0x57, 0x45, 0xd3, 0x02, 0x82, 0xfa, 0xdb, 0xb0, 0x6c, 0xb8, 0x9c, 0xba, 0x75,
+	0xa1, 0x16, 0xd2, 0x6a, 0xe5, 0x43, 0xbe, 0x25, 0xe7, 0xfd, 0x44, 0xe4, 0x96, 0xd0, 0xe5, 0xa5,
+	0xa2, 0xfa, 0x25, 0xe2, 0xf4, 0x0e, 0xf4, 0xce, 0x66, 0x51, 0x34, 0xb0, 0xd5, 0x71, 0x76, 0x3b,
+	0x7e, 0x89, 0xef, 0xfd, 0x18, 0xe0, 0x7b, 0x21, 0x3b, 0x1f, 0xa5, 0xf8, 0x39, 0x8e, 0x64, 0x2c,
+	0xf2, 0x20, 0x72, 0x74, 0x2c, 0xf2, 0xc8, 0xb9, 0x0d, 0xab, 0x17, 0x38, 0x0a, 0x47, 0x98, 0x25,
+	0xa9, 0x8c, 0x31, 0xb9, 0x68, 0x81, 0xcb, 0xa3, 0x01, 0x8f, 0x46, 0x29, 0xa1, 0x54, 0xa5, 0x88,
+	0x26, 0xb9, 0x63, 0xf1, 0x24, 0x99, 0xc5, 0x4c, 0x25, 0x8a, 0xa2, 0xbc, 0xdf, 0xb4, 0xa1, 0x29,
+	0x61, 0x68, 0x0b, 0x5a, 0xb1, 0x34, 0x48, 0xb9, 0x3e, 0xce, 0x72, 0x4e, 0x64, 0x4c, 0xcd, 0xc8,
+	0x98, 0x6d, 0x80, 0x29, 0xe6, 0x08, 0x26, 0x1c, 0x24, 0x97, 0x32, 0x38, 0x79, 0xee, 0x34, 0xcc,
+	0xdc, 0xd9, 0x06, 0xa0, 0xe7, 0xf8, 0xbd, 0xcf, 0xe2, 0x20, 0x22, 0x54, 0x65, 0xa6, 0xc1, 0xe1,
+	0x5e, 0x8f, 0x92, 0x31, 0x3d, 0x88, 0x92, 0x64, 0xa2, 0x12, 0x34, 0x67, 0x14, 0x32, 0x8d, 0xfa,
+	0x49, 0xa2, 0x13, 0xb6, 0xc4, 0xe7, 0x33, 0xf1, 0xc0, 0x21, 0x42, 0x48, 0xe6, 0x6f, 0xce, 0xe0,
+	0xc1, 0x94, 0x92, 0x80, 0x84, 0x53, 0x26, 0x67, 0x91, 0xd9, 0x6c, 0xf1, 0xb8, 0x05, 0x93, 0x30,
+	0x26, 0xa9, 0x4a, 0x66, 0x49, 0x70, 0x0b, 0x46, 0xe1, 0xd9, 0x59, 0x18, 0xcc, 0x22, 0x76, 0xa5,
+	0xd2, 0xd8, 0xe0, 0xa0, 0x5d, 0x58, 0x63, 0x09, 0xc3, 0xd1, 0xfd, 0x5c, 0x48, 0x66, 0x76, 0x91,
+	0xcd, 0x35, 0x24, 0x97, 0x2c, 0xc5, 0xf7, 0x31, 0xc3, 0x2a, 0xdf, 0x73, 0x06, 0xf7, 0x39, 0x0d,
+	0xbf, 0x24, 0x2a, 0xf5, 0xc5, 0xb3, 0x42, 0xb5, 0xe3, 0x70, 0x12, 0x32, 0x85, 0x00, 0x19, 0xcd,
+	0xf7, 0x7d, 0x8c, 0xe9, 0x67, 0x94, 0x64, 0x28, 0xa0, 0x48, 0xbe, 0x0e, 0x0b, 0x27, 0x84, 0x32,
+	0x3c, 0x99, 0x2a, 0x08, 0xc8, 0x19, 0xfc, 0x10, 0x1b, 0x62, 0x4a, 0x8a, 0x89, 0x6f, 0x33, 0xf9,
+	0xec, 0x93, 0xf0, 0x52, 0x6c, 0xf5, 0x86, 0x9c, 0x5d, 0x91, 0xdc, 0xde, 0xe7, 0x59, 0xec, 0x4a,
+	0x67, 0x6e, 0x4a, 0x7b, 0x0b, 0x6c, 0xf4, 0x1e, 0x74, 0x0d, 0x96, 0x7b, 0x43, 0xe4, 0xe6, 0xba,
+	0xca, 0xcd, 0x3c, 0xfe, 0x7d, 0x53, 0x4a, 0x25, 0xda, 0xf0, 0x48, 0x99, 0xb6, 0x95, 0x25, 0x9a,
+	0x66, 0x71, 0x03, 0xc8, 0x65, 0x40, 0x28, 0x3d, 0x90, 0x4c, 0xf7, 0xa6, 0x34, 0xc0, 0x62, 0xa2,
+	0xf7, 0xe1, 0x86, 0x0c, 0xce, 0x03, 0x82, 0x83, 0x24, 0x96, 0xa9, 0xce, 0x95, 0x75, 0x85, 0x74,
+	0xf5, 0x20, 0x4f, 0x88, 0x99, 0x0c, 0xd5, 0x5b, 0x3b, 0x75, 0x9e, 0x10, 0x92, 0x42, 0x77, 0x61,
+	0xdd, 0x08, 0x38, 0xee, 0x07, 0x42, 0xdd, 0xbe, 0x10, 0x29, 0x0f, 0x94, 0x50, 0xe9, 0xb5, 0x97,
+	0x44, 0xa5, 0x14, 0x7a, 0x39, 0x2a, 0x2d, 0x00, 0x1d, 0x3d, 0x68, 0x0e, 0xb3, 0xf2, 0xa2, 0xbb,
+	0xb7, 0xac, 0x14, 0x90, 0x0b, 0xc8, 0x21, 0xef, 0xb7, 0x35, 0xa8, 0x1f, 0x27, 0x63, 0x13, 0x46,
+	0x9c, 0x12, 0x8c, 0xb0, 0x64, 0x1a, 0x06, 0xd4, 0xad, 0x49, 0x9f, 0x48, 0x8a, 0x07, 0xec, 0x88,
+	0x47, 0xb2, 0x3a, 0x98, 0xf9, 0x73, 0x11, 0x26, 0x1b, 0x65, 0x98, 0xb4, 0x60, 0xb6, 0x59, 0x84,
+	0x59, 0x9e, 0x4c, 0xb6, 0x3b, 0x15, 0x28, 0x14, 0xd9, 0x95, 0x87, 0x70, 0x7b, 0xce, 0x21, 0xdc,
+	0x87, 0x4e, 0x94, 0x8c, 0xa5, 0x8c, 0x44, 0x86, 0x8c, 0xe6, 0x76, 0xa7, 0x64, 0x92, 0x5c, 0x90,
+	0x91, 0xc0, 0x84, 0x8e, 0xaf, 0x49, 0xef, 0xdf, 0x0d, 0x68, 0xfb, 0x12, 0x1f, 0xaa, 0xf4, 0x72,
+	0x5e, 0x5e, 0xaf, 0xda, 0x1c, 0xbd, 0x2c, 0x5f, 0xd4, 0x5f, 0x70, 0xe4, 0x34, 0xe6, 0x97, 0x46,
+	0x4d, 0xa3, 0x34, 0x92, 0xc5, 0x4a, 0x2b, 0x2b, 0x56, 0xee, 0xc2, 0x7a, 0x30, 0x9b, 0xcc, 0x22,
+	0xcc, 0xc2, 0x0b, 0xa2, 0xb3, 0x4a, 0x3a, 0xaa, 0x3c, 0x60, 0x82, 0x4a, 0xc7, 0x06, 0x95, 0xbb,
+	0xb0, 0x4e, 0xce, 0xce, 0x48, 0xa0, 0xa4, 0x65, 0xa5, 0x25, 0x51, 0xb4, 0x3c, 0xc0, 0xfd, 0x15,
+	0x24, 0x31, 0x4b, 0x71, 0xc0, 0xf6, 0x55, 0x54, 0x49, 0x50, 0x2d, 0xb2, 0xd1, 0x36, 0x34, 0x38,
+	0xde, 0xbb, 0x5d, 0x91, 0x23, 0xa0, 0x42, 0xf4, 0x38, 0x19, 0xfb, 0x82, 0x6f, 0x1f, 0x10, 0xcb,
+	0xc5, 0x03, 0x22, 0xcf, 0x8e, 0x15, 0x2b, 0x3b, 0x10, 0x34, 0x52, 0x9e, 0xec, 0x0a, 0x4c, 0xf9,
+	0x73, 0x65, 0x29, 0x55, 0x40, 0x9b, 0x5e, 0x19, 0x6d, 0x3c, 0x58, 0x56, 0xa4, 0x34, 0x59, 0x95,
+	0x54, 0x26, 0xaf, 0xa2, 0x3c, 0x42, 0xaf, 0x50, 0x1e, 0x05, 0x70, 0x83, 0x57, 0x47, 0x06, 0x2a,
+	0xa8, 0x8a, 0xe4, 0xe5, 0x83, 0x6e, 0x47, 0x22, 0xad, 0x8a, 0x56, 0x11, 0x6f, 0x1d, 0xdf, 0x64,
+	0x79, 0x7f, 0x71, 0x60, 0xab, 0xb8, 0xca, 0x02, 0x10, 0xe6, 0x7d, 0xe8, 0x1a, 0xba, 0x28, 0x9c,
+	0xa9, 0x02, 0x3a, 0x53, 0x0c, 0xed, 0xf2, 0x9c, 0x93, 0xaa, 0x36, 0xc4, 0x1b, 0xab, 0xea, 0x0d,
+	0xa5, 0xad, 0xaf, 0x87, 0xbd, 0x4f, 0xe0, 0xf5, 0xa2, 0xd6, 0x52, 0xe2, 0x55, 0x5d, 0xe4, 0xfd,
+	0xdc, 0x81, 0x37, 0xe6, 0x4c, 0xb5, 0x00, 0x3f, 0x18, 0x16, 0xd5, 0xaf, 0xb7, 0xe8, 0x17, 0x72,
+	0x23, 0xf6, 0x83, 0x80, 0xd7, 0x62, 0xa7, 0xa2, 0x40, 0x51, 0xc6, 0xbc, 0x03, 0x8d, 0x67, 0x61,
+	0x3c, 0x12, 0xcb, 0xaf, 0xee, 0xdd, 0x54, 0x33, 0x98, 0x92, 0x9f, 0x86, 0xf1, 0xc8, 0x17, 0x42,
+	0x3c, 0x2f, 0x14, 0x40, 0x13, 0x0d, 0xcc, 0x39, 0x43, 0x14, 0x13, 0x51, 0xc2, 0x34, 0x36, 0xd3,
+	0x48, 0x96, 0x37, 0xf2, 0x34, 0x50, 0x05, 0x9a, 0x20, 0xbc, 0x73, 0x58, 0x56, 0x2b, 0x7c, 0x2e,
+	0xae, 0x26, 0xf3, 0xcf, 0x81, 0xec, 0xfd, 0x9a, 0xf1, 0xfe, 0xbc, 0x95, 0xe4, 0xa5, 0xa7, 0x61,
+	0x5c, 0x7a, 0xbc, 0x9f, 0x39, 0x70, 0xb3, 0x64, 0xf9, 0x42, 0x2e, 0xb6, 0x2d, 0xb1, 0x80, 0xae,
+	0xfe, 0x37, 0x6c, 0xc7, 0x09, 0xb3, 0x7c, 0x25, 0xe2, 0x7d, 0x13, 0x96, 0x8e, 0x93, 0xf1, 0x40,
+	0x9e, 0x60, 0x1e, 0x2c, 0xe3, 0x88, 0x91, 0x34, 0x16, 0x18, 0xc8, 0xd7, 0xe6, 0x6e, 0xb4, 0x78,
+	0xde, 0x57, 0x8e, 0x68, 0x09, 0x1c, 0x27, 0x63, 0x6a, 0xdc, 0x14, 0x72, 0xd7, 0x3b, 0x45, 0xd7,
+	0xef, 0x5a, 0xc7, 0x65, 0x77, 0xaf, 0x97, 0x43, 0x9a, 0x5c, 0x36, 0x3b, 0x40, 0x5f, 0x87, 0x25,
+	0x0e, 0xd9, 0x66, 0x07, 0x20, 0x67, 0xf0, 0x8d, 0x60, 0xc9, 0x81, 0xb1, 0x61, 0x9a, 0x7c, 0xc1,
+	0x11, 0xba, 0x05, 0xad, 0x60, 0x96, 0xd2, 0x24, 0x55, 0x87, 0x80, 0xa2, 0xf8, 0xa6, 0x44, 0xa2,
+	0x90, 0xe4, 0xe0, 0xbf, 0xe2, 0x4b, 0xc2, 0xfb, 0x9b, 0x03, 0x6b, 0x99, 0x79, 0x0b, 0xd8, 0x0c,
+	0x0d, 0xe7, 0xf5, 0xf9, 0x70, 0x9e, 0xdb, 0xdc, 0xb8, 0xc6, 0xe6, 0xa6, 0x6d, 0xf3, 0x36, 0x40,
+	0x4c, 0x2e, 0xd9, 0xa1, 0x69, 0x99, 0xc1, 0xf1, 0xfe, 0xea, 0xc0, 0xc6, 0x21, 0x8e, 0xa2, 0x43,
+	0x75, 0xbc, 0xe8, 0xbd, 0xea, 0x43, 0x47, 0x9f, 0x38, 0xca, 0x9a, 0x8c, 0xe6, 0x5d, 0x04, 0x3c,
+	0x0c, 0x95, 0x19, 0xfc, 0x51, 0x04, 0xff, 0x30, 0x7c, 0x82, 0x27, 0x24, 0xbb, 0x4b, 0x49, 0x92,
+	0xcf, 0x73, 0x36, 0x8b, 0x25, 0xca, 0x49, 0xb5, 0x33, 0x9a, 0xa7, 0x00, 0x4e, 0xc7, 0xfa, 0x76,
+	0x23, 0x9e, 0xb3, 0xa3, 0xb9, 0x65, 0x1c, 0xcd, 0x59, 0x02, 0xb5, 0xcd, 0x04, 0xfc, 0xa7, 0x03,
+	0x9b, 0xb6, 0xe6, 0x0b, 0xd8, 0x06, 0xd3, 0xec, 0x7a, 0xc1, 0xec, 0xeb, 0x4c, 0xc9, 0x54, 0x6c,
+	0x9a, 0x39, 0xee, 0x42, 0x3b, 0x99, 0xb1, 0xe9, 0x8c, 0x51, 0x65, 0x8f, 0x26, 0xf9, 0xb6, 0xa4,
+	0x84, 0xcd, 0xd2, 0x58, 0xdc, 0x69, 0xa4, 0x5d, 0x06, 0xc7, 0x3b, 0x84, 0x5b, 0xa7, 0x24, 0x1e,
+	0xf9, 0xf8, 0x79, 0xc5, 0xf9, 0x76, 0x1b, 0x56, 0x53, 0x6b, 0x40, 0x19, 0x5a, 0xe0, 0x7a, 0x8f,
+	0xa0, 0xbb, 0x1f, 0x04, 0x84, 0xd2, 0xc1, 0x6c, 0x1a, 0x5d, 0x87, 0x50, 0x3b, 0xd0, 0xa5, 0x2c,
+	0x49, 0xf1, 0x98, 0x7c, 0x4a, 0xae, 0x34, 0x2a, 0x9a, 0x2c, 0xef, 0x8f, 0x0e, 0xac, 0xec, 0xcf,
+	0xd8, 0x79, 0x92, 0x86, 0x5f, 0x62, 0x61, 0xb1, 0xd1, 0x4c, 0x71, 0xec, 0x66, 0x8a, 0xb1, 0x4e,
+	0xad, 0x84, 0x84, 0xf2, 0xaa, 0x5b, 0x37, 0xaf, 0xba, 0x2e, 0xb4, 0xaf, 0x4e, 0x30, 0x6f, 0x9c,
+	0xe8, 0x84, 0x55, 0xa4, 0x6c, 0xf0, 0x34, 0xad, 0x06, 0x8f, 0xf4, 0xa3, 0x23, 0x12, 0x02, 0x4b,
+	0x85, 0xd8, 0x95, 0x72, 0x60, 0xce, 0xf0, 0xbe, 0x6a, 0x00, 0xba, 0x4f, 0x82, 0x64, 0x44, 0x46,
+	0x66, 0x4f, 0x4d, 0x97, 0x32, 0x8e, 0x51, 0xca, 0x54, 0xdd, 0xd9, 0xab, 0x7a, 0x67, 0x86, 0xc1,
+	0x0d, 0xdb, 0xe0, 0xcc, 0xac, 0xa6, 0x69, 0x96, 0xd9, 0x59, 0x6b, 0x15, 0x3a, 0x6b, 0xf3, 0x7a,
+	0x49, 0xed, 0x6b, 0x7a, 0x49, 0xc5, 0x1e, 0x55, 0xa7, 0xa2, 0x47, 0xa5, 0xfa, 0x7b, 0x4b, 0x79,
+	0x7f, 0x4f, 0x96, 0xb5, 0x90, 0x95, 0xb5, 0xd5, 0x7d, 0xb5, 0xac, 0xab, 0xb7, 0x6c, 0x76, 0xf5,
+	0xf6, 0x00, 0xb0, 0x88, 0x9f, 0xe3, 0x90, 0x32, 0x77, 0xc5, 0xba, 0x8c, 0x19, 0x81, 0xe5, 0x1b,
+	0x52, 0xbc, 0x8c, 0xcf, 0x34, 0xd2, 0xf7, 0x4c, 0x59, 0x4c, 0x96, 0xf8, 0xe8, 0x5d, 0xd8, 0xe0,
+	0xe5, 0xe0, 0xe7, 0x24, 0xa5, 0x61, 0x12, 0x93, 0x91, 0xba, 0x1e, 0xae, 0x89, 0xf0, 0xab, 0x1a,
+	0x42, 0x07, 0xb0, 0x8e, 0xcd, 0x28, 0x14, 0x8a, 0xf5, 0xac, 0x9a, 0xd1, 0x8a, 0x52, 0xbf, 0x2c,
+	0x2e, 0x7b, 0x88, 0xeb, 0x56, 0x0f, 0x11, 0x59, 0x21, 0xb6, 0xa1, 0x43, 0xcc, 0x08, 0xcc, 0x4d,
+	0x2b, 0x30, 0xbd, 0x3f, 0x38, 0xd0, 0xaf, 0xca, 0xcf, 0x05, 0x20, 0x90, 0x0e, 0xc7, 0xba, 0x11,
+	0x8e, 0x1f, 0xd9, 0xd5, 0xa2, 0xac, 0xfd, 0x6e, 0x29, 0x83, 0xcb, 0x61, 0x6e, 0x15, 0x8d, 0xde,
+	0xdf, 0x1d, 0xd8, 0xb4, 0x94, 0xfc, 0x21, 0x11, 0x0f, 0x5c, 0xcb, 0x94, 0x60, 0x9a, 0xc1, 0x87,
+	0xa2, 0x44, 0x0b, 0xc3, 0x52, 0x50, 0x93, 0xe8, 0x00, 0xda, 0x23, 0xc2, 0x70, 0x18, 0xe9, 0x73,
+	0x6a, 0xb7, 0xa2, 0x62, 0xd5, 0xf3, 0xdf, 0xbb, 0x2f, 0x45, 0x1f, 0xc4, 0x2c, 0xbd, 0xf2, 0xf5,
+	0x8b, 0xfd, 0x0f, 0x61, 0xd9, 0x1c, 0xe0, 0x21, 0xfb, 0x8c, 0x5c, 0x29, 0x15, 0xf8, 0x63, 0x1e,
+	0xa2, 0x35, 0x23, 0x44, 0x3f, 0xac, 0x7d, 0xe0, 0x78, 0xff, 0x72, 0xa0, 0xc3, 0x21, 0x7f, 0xdf,
+	0x3c, 0x29, 0x9c, 0xd2, 0x25, 0xae, 0x96, 0x45, 0xbb, 0xca, 0x87, 0x7a, 0x75, 0xbf, 0xbb, 0x51,
+	0xc8, 0xca, 0x62, 0x86, 0x35, 0x5f, 0xa1, 0x0b, 0xdc, 0xba, 0x26, 0x73, 0x33, 0x83, 0xda, 0x66,
+	0xce, 0xe9, 0x36, 0x40, 0x27, 0x6f, 0x03, 0x78, 0x47, 0x80, 0x4e, 0x67, 0xe3, 0x31, 0xa1, 0xec,
+	0x21, 0x21, 0x59, 0xdd, 0xf4, 0x2d, 0x7b, 0xfb, 0x1d, 0xeb, 0x23, 0x84, 0xf6, 0x87, 0xbd, 0xe9,
+	0x18, 0xda, 0x0f, 0x09, 0x19, 0x84, 0x24, 0x2d, 0x59, 0xe5, 0xbc, 0x82, 0x55, 0xb5, 0xf9, 0x56,
+	0x79, 0x7f, 0xaa, 0xc1, 0x86, 0xa5, 0xec, 0x02, 0x82, 0xbf, 0xd4, 0x63, 0xab, 0x57, 0xf5, 0xd8,
+	0x76, 0xa0, 0x1b, 0x93, 0xe7, 0xd9, 0x37, 0x20, 0x75, 0xc1, 0x37, 0x58, 0xc8, 0x13, 0xc5, 0xf5,
+	0x73, 0xb7, 0x69, 0xdd, 0x29, 0x94, 0x47, 0x44, 0xb1, 0xfd, 0x1c, 0xdd, 0x81, 0x0e, 0x65, 0x38,
+	0x1e, 0xe1, 0x74, 0xe4, 0xb6, 0x2a, 0xe5, 0xb2, 0x71, 0x3e, 0xdf, 0x19, 0xa6, 0xb2, 0x04, 0xac,
+	0x98, 0x8f, 0x8f, 0x71, 0xad, 0xc6, 0x98, 0x3e, 0xa0, 0x2c, 0x9c, 0x60, 0xa6, 0xbf, 0x84, 0x98,
+	0x2c, 0xef, 0x0b, 0xb8, 0x39, 0x48, 0x71, 0x40, 0xfe, 0xaf, 0x2b, 0x6b, 0x1f, 0x3a, 0xd3, 0x94,
+	0x70, 0x9f, 0x12, 0x75, 0x5f, 0xcd, 0x68, 0xef, 0xd7, 0x35, 0x58, 0xe2, 0xf1, 0xf0, 0x30, 0xe5,
+	0xa5, 0xd7, 0x9c, 0xc3, 0x4e, 0x24, 0x4d, 0xad, 0x94, 0x34, 0xf5, 0xf2, 0x11, 0x61, 0xde, 0x42,
+	0x74, 0x2a, 0x35, 0xf3, 0x54, 0x32, 0x7a, 0x1e, 0x2d, 0xbb, 0xe7, 0x51, 0xfd, 0x91, 0x68, 0x0b,
+	0x5a, 0xb2, 0xfc, 0x51, 0xbe, 0x51, 0x14, 0x97, 0x16, 0x41, 0xa0, 0x8e, 0x2d, 0x49, 0xc8, 0xc6,
+	0xf3, 0x05, 0x49, 0x99, 0x2f, 0x31, 0x0a, 0x74, 0xe3, 0x39, 0xe7, 0xa1, 0xdb, 0xd0, 0x0c, 0x70,
+	0x14, 0xe9, 0x26, 0x48, 0xcf, 0x48, 0x09, 0xe1, 0x02, 0x5f, 0x0e, 0x7b, 0x3f, 0x75, 0x60, 0x45,
+	0x5c, 0x67, 0x84, 0xe7, 0xcf, 0x64, 0x07, 0x68, 0x81, 0xbe, 0xf1, 0x44, 0xdf, 0x32, 0x20, 0xba,
+	0x65, 0xd3, 0xdc, 0xa9, 0xef, 0xae, 0xf8, 0x16, 0xcf, 0xfb, 0x87, 0x03, 0x6b, 0x27, 0x6a, 0xa3,
+	0xd4, 0x0d, 0x8b, 0x7b, 0x70, 0x88, 0x23, 0x1c, 0x07, 0x5a, 0x11, 0x4d, 0xe6, 0x25, 0x05, 0x57,
+	0xa6, 0xa1, 0x4b, 0x0a, 0x04, 0x0d, 0x8e, 0xf5, 0xfa, 0x6c, 0xe0, 0xcf, 0xe8, 0x63, 0x68, 0xab,
+	0x42, 0xcd, 0x6d, 0x08, 0x2f, 0xbc, 0xa5, 0xbc, 0x50, 0x58, 0xec, 0xde, 0xa9, 0x94, 0x52, 0x70,
+	0xac, 0xde, 0xe1, 0x70, 0x6c, 0x0e, 0xbc, 0x12, 0x1c, 0xff, 0xa7, 0x06, 0x6e, 0x39, 0xa0, 0x17,
+	0xd2, 0x15, 0x28, 0x65, 0x43, 0xbd, 0x3a, 0x1b, 0xde, 0x86, 0x06, 0xdf, 0x62, 0x75, 0x24, 0x96,
+	0x03, 0x40, 0x8c, 0xa2, 0x3d, 0x58, 0x62, 0x6a, 0xe7, 0xe5, 0xe6, 0xe4, 0xe5, 0x82, 0x15, 0x16,
+	0x7e, 0x2e, 0x86, 0x1e, 0x19, 0x79, 0xd6, 0x12, 0xaf, 0x7c, 0x23, 0x3f, 0xec, 0x2a, 0x4d, 0xce,
+	0x3c, 0x2e, 0x5d, 0x9c, 0xbd, 0xde, 0x3f, 0x85, 0x15, 0x6b, 0xa8, 0xc2, 0xc9, 0x77, 0x4d, 0x27,
+	0x77, 0xf7, 0xb6, 0xaa, 0xf7, 0xd0, 0x74, 0xfe, 0xef, 0x6a, 0xb0, 0x62, 0xf5, 0xc7, 0x78, 0x74,
+	0x64, 0x6d, 0x90, 0x25, 0xd5, 0xed, 0xe0, 0xbb, 0x90, 0xcc, 0xd2, 0x40, 0xfb, 0x59, 0x51, 0x7c,
+	0x53, 0x45, 0x37, 0x4d, 0x57, 0xe2, 0x82, 0xc8, 0x32, 0xa0, 0x51, 0xca, 0x80, 0x66, 0x96, 0x01,
+	0x2e, 0xb4, 0xe9, 0x94, 0xc4, 0x23, 0xa2, 0x6f, 0x93, 0x9a, 0x34, 0x3e, 0x9b, 0xb5, 0xcd, 0xcf,
+	0x66, 0x1c, 0xd4, 0x47, 0x21, 0x9d, 0x46, 0xf8, 0x6a, 0x5f, 0x0e, 0xcb, 0xf4, 0xb7, 0x99, 0x42,
+	0xd3, 0xab, 0xc9, 0x30, 0x89, 0x14, 0x0c, 0x28, 0x8a, 0xe3, 0xdd, 0x88, 0x04, 0xe1, 0x84, 0x7f,
+	0x09, 0x01, 0x71, 0x03, 0xcf, 0x68, 0xab, 0x3f, 0xdd, 0xb5, 0xfb, 0xd3, 0xde, 0x0f, 0x64, 0xdf,
+	0x8e, 0xdb, 0x75, 0x20, 0x93, 0x4a, 0x63, 0x6d, 0x66, 0xbb, 0x63, 0xda, 0x7e, 0xed, 0xad, 0x65,
+	0x68, 0xb4, 0x1a, 0x24, 0xe1, 0xfd, 0xbe, 0x06, 0x37, 0x4b, 0x0b, 0x2c, 0x20, 0xf6, 0xab, 0x77,
+	0xc6, 0xd0, 0xae, 0x31, 0x47, 0xbb, 0xe2, 0xcd, 0x53, 0x23, 0x4b, 0xcb, 0x46, 0x96, 0xdb, 0xb0,
+	0xaa, 0x1c, 0xaf, 0xb4, 0x56, 0xbb, 0x55, 0xe0, 0xf2, 0x58, 0x88, 0xf9, 0x7d, 0x5e, 0x95, 0x27,
+	0xfc, 0xf9, 0x7f, 0xd9, 0xa3, 0x3b, 0x8f, 0xa1, 0x57, 0xec, 0xc4, 0xa1, 0x2e, 0xb4, 0x0f, 0xf6,
+	0x8f, 0xf7, 0x9f, 0x1c, 0x3e, 0xe8, 0x7d, 0x0d, 0xdd, 0x80, 0xf5, 0x81, 0xbf, 0xff, 0xe4, 0x74,
+	0xff, 0x70, 0xf0, 0xe8, 0xe9, 0x93, 0x2f, 0x0e, 0x9f, 0x7e, 0xf6, 0x64, 0xd0, 0x73, 0x50, 0x07,
+	0x1a, 0x87, 0x4f, 0xef, 0x3f, 0xe8, 0xd5, 0xb8, 0xf4, 0xe9, 0xe0, 0xa9, 0xbf, 0x7f, 0xf4, 0xa0,
+	0x57, 0xdf, 0xfb, 0x73, 0x1b, 0xda, 0x0f, 0xd8, 0xf9, 0x91, 0x7f, 0x72, 0x88, 0x3e, 0x80, 0xb6,
+	0xfa, 0xa3, 0x07, 0xba, 0xa1, 0x12, 0xc6, 0xfe, 0x13, 0x4a, 0x7f, 0xab, 0xc8, 0x56, 0x1b, 0xf4,
+	0x7d, 0x40, 0xe5, 0x0f, 0xeb, 0x68, 0x27, 0x97, 0xae, 0xfe, 0x07, 0x47, 0xff, 0xcd, 0x6b, 0x24,
+	0xd4, 0xd4, 0x1f, 0x41, 0x47, 0x7f, 0x8b, 0x42, 0xc6, 0xf2, 0xe6, 0x27, 0xf3, 0xfe, 0xcd, 0x12,
+	0x5f, 0xbd, 0xfc, 0x58, 0xf4, 0xcc, 0xac, 0x7f, 0x81, 0x18, 0x2b, 0x96, 0xca, 0x86, 0xfe, 0x1b,
+	0x73, 0x46, 0xd5, 0x74, 0xc3, 0x72, 0x87, 0x5c, 0x7e, 0x96, 0x79, 0x6b, 0xce, 0x7b, 0x66, 0x8f,
+	0xb8, 0xff, 0xf6, 0xf5, 0x42, 0x6a, 0x8d, 0x13, 0xd1, 0x07, 0x33, 0xb7, 0x18, 0x19, 0x5a, 0x55,
+	0xb4, 0x6b, 0xfb, 0xdb, 0xf3, 0x86, 0xd5, 0x8c, 0x1f, 0x03, 0x9c, 0xb2, 0x94, 0xe0, 0x09, 0x6f,
+	0xae, 0x99, 0x3b, 0x6b, 0xf4, 0x12, 0xfb, 0x5b, 0x45, 0xb6, 0x7c, 0xf9, 0x5d, 0x07, 0x1d, 0xc1,
+	0xb2, 0xd9, 0x16, 0x42, 0x7d, 0xe3, 0x50, 0x28, 0x74, 0xb9, 0xfa, 0xaf, 0x55, 0x8e, 0xe5, 0x41,
+	0x52, 0xbe, 0xe3, 0x65, 0x41, 0x32, 0xb7, 0x3d, 0xd3, 0x7f, 0xf3, 0x1a, 0x09, 0x35, 0xf5, 0x7d,
+	0xe8, 0x1a, 0xa5, 0x33, 0xd2, 0x57, 0xb9, 0x72, 0xed, 0xdf, 0xef, 0x57, 0x0d, 0xa9, 0x59, 0x4e,
+	0xa1, 0x57, 0x3c, 0x8b, 0xd0, 0xf6, 0xdc, 0x43, 0x4a, 0xce, 0xf7, 0xf5, 0x17, 0x1c, 0x62, 0x6a,
+	0x3f, 0x4d, 0x58, 0x33, 0xf7, 0xb3, 0x02, 0x4f, 0xfb, 0xdb, 0xf3, 0x86, 0xe5, 0x8c, 0xc3, 0x96,
+	0x18, 0x7e, 0xef, 0xbf, 0x03, 0x00, 0x8b, 0xbf, 0x5c, 0xee, 0x3a, 0x26, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EthGRPCClient is the client API for EthGRPC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EthGRPCClient interface {
+	GetSync(ctx context.Context, in *GetSyncRequest, opts ...grpc.CallOption) (*GetSyncResponse, error)
+	GetTxsForBlockHash(ctx context.Context, in *GetTxsForBlockHashRequest, opts ...grpc.CallOption) (*GetTxsForBlockHashResponse, error)
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error)
+	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
+	StreamLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (EthGRPC_StreamLogsClient, error)
+	CallContract(ctx context.Context, in *CallContractRequest, opts ...grpc.CallOption) (*CallContractResponse, error)
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
+	SuggestFees(ctx context.Context, in *SuggestFeesRequest, opts ...grpc.CallOption) (*SuggestFeesResponse, error)
+	TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error)
+	GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error)
+}
+
+type ethGRPCClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEthGRPCClient(cc *grpc.ClientConn) EthGRPCClient {
+	return &ethGRPCClient{cc}
+}
+
+func (c *ethGRPCClient) GetSync(ctx context.Context, in *GetSyncRequest, opts ...grpc.CallOption) (*GetSyncResponse, error) {
+	out := new(GetSyncResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) GetTxsForBlockHash(ctx context.Context, in *GetTxsForBlockHashRequest, opts ...grpc.CallOption) (*GetTxsForBlockHashResponse, error) {
+	out := new(GetTxsForBlockHashResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetTxsForBlockHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	out := new(GetBlockResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error) {
+	out := new(GetTransactionReceiptResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetTransactionReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
+	out := new(GetAccountStateResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetAccountState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) StreamLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (EthGRPC_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EthGRPC_serviceDesc.Streams[0], "/proto.EthGRPC/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ethGRPCStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EthGRPC_StreamLogsClient interface {
+	Recv() (*GetLogsResponse, error)
+	grpc.ClientStream
+}
+
+type ethGRPCStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ethGRPCStreamLogsClient) Recv() (*GetLogsResponse, error) {
+	m := new(GetLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ethGRPCClient) CallContract(ctx context.Context, in *CallContractRequest, opts ...grpc.CallOption) (*CallContractResponse, error) {
+	out := new(CallContractResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/CallContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error) {
+	out := new(SendRawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/SendRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) SuggestFees(ctx context.Context, in *SuggestFeesRequest, opts ...grpc.CallOption) (*SuggestFeesResponse, error) {
+	out := new(SuggestFeesResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/SuggestFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error) {
+	out := new(TraceTransactionResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/TraceTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethGRPCClient) GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error) {
+	out := new(GetTokenBalanceResponse)
+	err := c.cc.Invoke(ctx, "/proto.EthGRPC/GetTokenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EthGRPCServer is the server API for EthGRPC service.
+type EthGRPCServer interface {
+	GetSync(context.Context, *GetSyncRequest) (*GetSyncResponse, error)
+	GetTxsForBlockHash(context.Context, *GetTxsForBlockHashRequest) (*GetTxsForBlockHashResponse, error)
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error)
+	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
+	StreamLogs(*GetLogsRequest, EthGRPC_StreamLogsServer) error
+	CallContract(context.Context, *CallContractRequest) (*CallContractResponse, error)
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
+	SuggestFees(context.Context, *SuggestFeesRequest) (*SuggestFeesResponse, error)
+	TraceTransaction(context.Context, *TraceTransactionRequest) (*TraceTransactionResponse, error)
+	GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error)
+}
+
+func RegisterEthGRPCServer(s *grpc.Server, srv EthGRPCServer) {
+	s.RegisterService(&_EthGRPC_serviceDesc, srv)
+}
+
+func _EthGRPC_GetSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).GetSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/GetSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).GetSync(ctx, req.(*GetSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_GetTxsForBlockHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxsForBlockHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).GetTxsForBlockHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/GetTxsForBlockHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).GetTxsForBlockHash(ctx, req.(*GetTxsForBlockHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).GetTransactionReceipt(ctx, req.(*GetTransactionReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_GetAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).GetAccountState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/GetAccountState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).GetAccountState(ctx, req.(*GetAccountStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EthGRPCServer).StreamLogs(m, &ethGRPCStreamLogsServer{stream})
+}
+
+type EthGRPC_StreamLogsServer interface {
+	Send(*GetLogsResponse) error
+	grpc.ServerStream
+}
+
+type ethGRPCStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ethGRPCStreamLogsServer) Send(m *GetLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EthGRPC_CallContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).CallContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/CallContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).CallContract(ctx, req.(*CallContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_SuggestFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).SuggestFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/SuggestFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).SuggestFees(ctx, req.(*SuggestFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/TraceTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).TraceTransaction(ctx, req.(*TraceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthGRPC_GetTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthGRPCServer).GetTokenBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EthGRPC/GetTokenBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthGRPCServer).GetTokenBalance(ctx, req.(*GetTokenBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EthGRPC_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.EthGRPC",
+	HandlerType: (*EthGRPCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSync",
+			Handler:    _EthGRPC_GetSync_Handler,
+		},
+		{
+			MethodName: "GetTxsForBlockHash",
+			Handler:    _EthGRPC_GetTxsForBlockHash_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _EthGRPC_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _EthGRPC_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactionReceipt",
+			Handler:    _EthGRPC_GetTransactionReceipt_Handler,
+		},
+		{
+			MethodName: "GetAccountState",
+			Handler:    _EthGRPC_GetAccountState_Handler,
+		},
+		{
+			MethodName: "CallContract",
+			Handler:    _EthGRPC_CallContract_Handler,
+		},
+		{
+			MethodName: "SendRawTransaction",
+			Handler:    _EthGRPC_SendRawTransaction_Handler,
+		},
+		{
+			MethodName: "SuggestFees",
+			Handler:    _EthGRPC_SuggestFees_Handler,
+		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _EthGRPC_TraceTransaction_Handler,
+		},
+		{
+			MethodName: "GetTokenBalance",
+			Handler:    _EthGRPC_GetTokenBalance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _EthGRPC_StreamLogs_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethgrpc.proto",
 }
@@ -0,0 +1,876 @@
+package router
+
+import (
+    "bytes"
+    "encoding/hex"
+    "encoding/json"
+    "io/ioutil"
+    "math/big"
+    "path/filepath"
+    "regexp"
+    "strconv"
+    "strings"
+    "sync"
+    "unicode/utf8"
+
+    "golang.org/x/crypto/sha3"
+)
+
+/* ----- ABI DEFINITIONS ----- */
+
+// ABIArgument is a function input or output, or an event field, as it
+// appears in ABI JSON. Components are the fields of tuple types.
+type ABIArgument struct {
+    Name string `json:"name"`
+    Type string `json:"type"`
+    Components []ABIArgument `json:"components,omitempty"`
+    Indexed bool `json:"indexed,omitempty"`
+}
+
+type ABIFunction struct {
+    Name string
+    Inputs []ABIArgument
+    Outputs []ABIArgument
+    StateMutability string
+
+    inputTypes []abiType
+    outputTypes []abiType
+}
+
+// ABI holds the functions of a contract ABI, by name. Overloaded functions
+// share a name.
+type ABI struct {
+    Functions map[string][]ABIFunction
+}
+
+type abiEntry struct {
+    Type string `json:"type"`
+    Name string `json:"name"`
+    Inputs []ABIArgument `json:"inputs"`
+    Outputs []ABIArgument `json:"outputs"`
+    StateMutability string `json:"stateMutability"`
+}
+
+// ParseABI reads ABI JSON, checking every function type. Entries other than
+// functions are skipped.
+func ParseABI(data []byte) (*ABI, error) {
+    var entries []abiEntry
+    err := json.Unmarshal(data, &entries)
+    if err != nil {
+        return nil, ErrInvalidABI
+    }
+
+    abi := &ABI{Functions: map[string][]ABIFunction{}}
+    for _, entry := range entries {
+        if entry.Type != "function" && entry.Type != "" {
+            continue
+        }
+        if entry.Name == "" {
+            return nil, ErrInvalidABI
+        }
+
+        fn := ABIFunction{
+            Name: entry.Name,
+            Inputs: entry.Inputs,
+            Outputs: entry.Outputs,
+            StateMutability: entry.StateMutability,
+        }
+
+        fn.inputTypes, err = parseABITypes(entry.Inputs)
+        if err != nil {
+            return nil, err
+        }
+        fn.outputTypes, err = parseABITypes(entry.Outputs)
+        if err != nil {
+            return nil, err
+        }
+
+        abi.Functions[fn.Name] = append(abi.Functions[fn.Name], fn)
+    }
+
+    return abi, nil
+}
+
+// Function finds a function by name, or by signature for overloads such as
+// "transfer(address,uint256)". An overloaded name is resolved by the number
+// of arguments.
+func (abi *ABI) Function(name string, argCount int) (ABIFunction, error) {
+    if strings.Contains(name, "(") {
+        for _, fn := range abi.Functions[name[:strings.Index(name, "(")]] {
+            if fn.Signature() == name {
+                return fn, nil
+            }
+        }
+        return ABIFunction{}, ErrUnknownFunction
+    }
+
+    var found []ABIFunction
+    for _, fn := range abi.Functions[name] {
+        if len(fn.Inputs) == argCount {
+            found = append(found, fn)
+        }
+    }
+
+    switch len(found) {
+    case 0:
+        return ABIFunction{}, ErrUnknownFunction
+    case 1:
+        return found[0], nil
+    }
+    return ABIFunction{}, ErrAmbiguousFunction
+}
+
+// Signatures lists the signatures of every function of the ABI.
+func (abi *ABI) Signatures() []string {
+    signatures := []string{}
+    for _, fns := range abi.Functions {
+        for _, fn := range fns {
+            signatures = append(signatures, fn.Signature())
+        }
+    }
+    return signatures
+}
+
+// Signature is the canonical form hashed into the selector.
+func (fn ABIFunction) Signature() string {
+    return fn.Name + tupleTypeString(fn.inputTypes)
+}
+
+func (fn ABIFunction) Selector() []byte {
+    return keccak256([]byte(fn.Signature()))[:4]
+}
+
+// EncodeCall builds calldata from JSON encoded arguments: numbers as JSON
+// numbers or decimal or hex strings, addresses, hashes and bytes as hex
+// strings, arrays as lists and tuples as lists or objects.
+func (fn ABIFunction) EncodeCall(args []json.RawMessage) ([]byte, error) {
+    if len(args) != len(fn.Inputs) {
+        return nil, ErrWrongArgumentCount
+    }
+
+    values := make([]interface{}, len(args))
+    for i, arg := range args {
+        decoder := json.NewDecoder(bytes.NewReader(arg))
+        decoder.UseNumber()
+        if decoder.Decode(&values[i]) != nil {
+            return nil, &ABIArgumentError{argumentName(fn.Inputs[i], i), fn.Inputs[i].Type}
+        }
+    }
+
+    encoded, err := encodeABITuple(fn.inputTypes, values, inputPaths(fn.Inputs))
+    if err != nil {
+        return nil, err
+    }
+
+    return append(fn.Selector(), encoded...), nil
+}
+
+// DecodeOutputs decodes return data into an object keyed by output name
+// when every output is named, and into a list otherwise. Integers come back
+// as decimal strings, so no precision is lost in JSON.
+func (fn ABIFunction) DecodeOutputs(data []byte) (interface{}, error) {
+    if len(data) == 0 && len(fn.Outputs) > 0 {
+        return nil, ErrEmptyCallResult
+    }
+
+    values, err := decodeABITuple(fn.outputTypes, data)
+    if err != nil {
+        return nil, err
+    }
+
+    return nameABIValues(fn.Outputs, values), nil
+}
+
+// ABIArgumentError names the argument that could not be encoded.
+type ABIArgumentError struct {
+    Argument string
+    Type string
+}
+
+func (e *ABIArgumentError) Error() string {
+    return "Error! Argument " + e.Argument + " is not a valid " + e.Type + "!"
+}
+
+func argumentName(arg ABIArgument, i int) string {
+    if arg.Name != "" {
+        return arg.Name
+    }
+    return strconv.Itoa(i)
+}
+
+func inputPaths(args []ABIArgument) []string {
+    paths := make([]string, len(args))
+    for i, arg := range args {
+        paths[i] = argumentName(arg, i)
+    }
+    return paths
+}
+
+func nameABIValues(args []ABIArgument, values []interface{}) interface{} {
+    named := map[string]interface{}{}
+    for i, arg := range args {
+        if arg.Name == "" {
+            return values
+        }
+        named[arg.Name] = values[i]
+    }
+
+    if len(args) == 0 {
+        return values
+    }
+    return named
+}
+
+/* ----- ABI REGISTRY ----- */
+
+var abiNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// DefaultMaxABIs is how many ABIs callers may register.
+const DefaultMaxABIs int = 256
+
+// ABIRegistry keeps ABIs registered by name, so callers need not send the
+// whole ABI with every call. ABIs loaded from a directory are fixed: the
+// operator put them there, so callers cannot replace them. Callers may
+// register at most MaxABIs more, fixed ones do not count.
+type ABIRegistry struct {
+    MaxABIs int
+
+    mu sync.RWMutex
+    abis map[string]*ABI
+    fixed map[string]bool
+}
+
+func NewABIRegistry() *ABIRegistry {
+    return &ABIRegistry{MaxABIs: DefaultMaxABIs, abis: map[string]*ABI{}, fixed: map[string]bool{}}
+}
+
+// Register parses data and stores it under name, replacing any ABI
+// registered before unless it was loaded from a directory. A new name fails
+// with ErrTooManyABIs once MaxABIs are registered.
+func (r *ABIRegistry) Register(name string, data []byte) (*ABI, error) {
+    return r.register(name, data, false)
+}
+
+func (r *ABIRegistry) register(name string, data []byte, fixed bool) (*ABI, error) {
+    if !abiNamePattern.MatchString(name) {
+        return nil, ErrInvalidABIName
+    }
+
+    abi, err := ParseABI(data)
+    if err != nil {
+        return nil, err
+    }
+
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    if r.fixed[name] {
+        return nil, ErrFixedABI
+    }
+    if _, ok := r.abis[name]; !ok && !fixed && len(r.abis) - r.fixedCount() >= r.MaxABIs {
+        return nil, ErrTooManyABIs
+    }
+
+    r.abis[name] = abi
+    r.fixed[name] = fixed
+    return abi, nil
+}
+
+func (r *ABIRegistry) fixedCount() int {
+    count := 0
+    for _, fixed := range r.fixed {
+        if fixed {
+            count++
+        }
+    }
+    return count
+}
+
+func (r *ABIRegistry) Get(name string) (*ABI, bool) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+
+    abi, ok := r.abis[name]
+    return abi, ok
+}
+
+// LoadDir registers every *.json file of dir under its name without the
+// extension, as fixed ABIs.
+func (r *ABIRegistry) LoadDir(dir string) error {
+    paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+    if err != nil {
+        return err
+    }
+
+    for _, path := range paths {
+        data, err := ioutil.ReadFile(path)
+        if err != nil {
+            return err
+        }
+
+        _, err = r.register(strings.TrimSuffix(filepath.Base(path), ".json"), data, true)
+        if err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
+
+/* ----- ABI TYPES ----- */
+type abiKind int
+
+const (
+    abiUint abiKind = iota
+    abiInt
+    abiAddress
+    abiBool
+    abiFixedBytes
+    abiBytes
+    abiString
+    abiSlice
+    abiArray
+    abiTuple
+)
+
+// abiType is a parsed ABI type. size is the bit size of integers, the byte
+// size of fixed bytes and the length of arrays.
+type abiType struct {
+    kind abiKind
+    size int
+    elem *abiType
+    components []abiType
+    names []string
+}
+
+// ABIs come from callers, so the types they declare are bounded: arrays and
+// tuples nest at most maxABIDepth deep, fixed arrays hold at most
+// maxABIArrayLength elements and no head, the static part of a tuple or
+// array, spans more than maxABIHeadSize bytes. Head sizes cannot overflow
+// within these bounds.
+const maxABIDepth int = 32
+const maxABIArrayLength int = 1 << 16
+const maxABIHeadSize int = 1 << 24
+
+func parseABITypes(args []ABIArgument) ([]abiType, error) {
+    return parseNestedABITypes(args, 0)
+}
+
+func parseNestedABITypes(args []ABIArgument, depth int) ([]abiType, error) {
+    types := make([]abiType, len(args))
+    for i, arg := range args {
+        t, err := parseABIType(arg.Type, arg.Components, depth)
+        if err != nil {
+            return nil, err
+        }
+        types[i] = t
+    }
+    return types, nil
+}
+
+// parseABIType parses t, depth arrays and tuples down.
+func parseABIType(t string, components []ABIArgument, depth int) (abiType, error) {
+    if depth > maxABIDepth {
+        return abiType{}, ErrInvalidABI
+    }
+
+    if strings.HasSuffix(t, "]") {
+        open := strings.LastIndex(t, "[")
+        if open < 0 {
+            return abiType{}, ErrInvalidABI
+        }
+
+        elem, err := parseABIType(t[:open], components, depth + 1)
+        if err != nil {
+            return abiType{}, err
+        }
+
+        length := t[open + 1:len(t) - 1]
+        if length == "" {
+            return abiType{kind: abiSlice, elem: &elem}, nil
+        }
+
+        size, err := strconv.Atoi(length)
+        if err != nil || size <= 0 || size > maxABIArrayLength || elem.headSize() > maxABIHeadSize / size {
+            return abiType{}, ErrInvalidABI
+        }
+        return abiType{kind: abiArray, size: size, elem: &elem}, nil
+    }
+
+    switch {
+    case t == "address":
+        return abiType{kind: abiAddress}, nil
+    case t == "bool":
+        return abiType{kind: abiBool}, nil
+    case t == "string":
+        return abiType{kind: abiString}, nil
+    case t == "bytes":
+        return abiType{kind: abiBytes}, nil
+    case t == "tuple":
+        types, err := parseNestedABITypes(components, depth + 1)
+        if err != nil {
+            return abiType{}, err
+        }
+
+        headSize := 0
+        for _, component := range types {
+            if component.headSize() > maxABIHeadSize - headSize {
+                return abiType{}, ErrInvalidABI
+            }
+            headSize += component.headSize()
+        }
+        return abiType{kind: abiTuple, components: types, names: inputPaths(components)}, nil
+    case strings.HasPrefix(t, "bytes"):
+        size, err := strconv.Atoi(t[len("bytes"):])
+        if err != nil || size < 1 || size > 32 {
+            return abiType{}, ErrInvalidABI
+        }
+        return abiType{kind: abiFixedBytes, size: size}, nil
+    case strings.HasPrefix(t, "uint"):
+        return parseABIIntType(abiUint, t[len("uint"):])
+    case strings.HasPrefix(t, "int"):
+        return parseABIIntType(abiInt, t[len("int"):])
+    }
+
+    return abiType{}, ErrInvalidABI
+}
+
+func parseABIIntType(kind abiKind, bits string) (abiType, error) {
+    if bits == "" {
+        return abiType{kind: kind, size: 256}, nil
+    }
+
+    size, err := strconv.Atoi(bits)
+    if err != nil || size < 8 || size > 256 || size % 8 != 0 {
+        return abiType{}, ErrInvalidABI
+    }
+    return abiType{kind: kind, size: size}, nil
+}
+
+func (t abiType) String() string {
+    switch t.kind {
+    case abiUint:
+        return "uint" + strconv.Itoa(t.size)
+    case abiInt:
+        return "int" + strconv.Itoa(t.size)
+    case abiAddress:
+        return "address"
+    case abiBool:
+        return "bool"
+    case abiFixedBytes:
+        return "bytes" + strconv.Itoa(t.size)
+    case abiBytes:
+        return "bytes"
+    case abiString:
+        return "string"
+    case abiSlice:
+        return t.elem.String() + "[]"
+    case abiArray:
+        return t.elem.String() + "[" + strconv.Itoa(t.size) + "]"
+    }
+    return tupleTypeString(t.components)
+}
+
+func tupleTypeString(types []abiType) string {
+    names := make([]string, len(types))
+    for i, t := range types {
+        names[i] = t.String()
+    }
+    return "(" + strings.Join(names, ",") + ")"
+}
+
+func (t abiType) isDynamic() bool {
+    switch t.kind {
+    case abiBytes, abiString, abiSlice:
+        return true
+    case abiArray:
+        return t.elem.isDynamic()
+    case abiTuple:
+        for _, component := range t.components {
+            if component.isDynamic() {
+                return true
+            }
+        }
+    }
+    return false
+}
+
+// headSize is the space t takes in the head of an enclosing tuple.
+func (t abiType) headSize() int {
+    if t.isDynamic() {
+        return 32
+    }
+
+    switch t.kind {
+    case abiArray:
+        return t.size * t.elem.headSize()
+    case abiTuple:
+        size := 0
+        for _, component := range t.components {
+            size += component.headSize()
+        }
+        return size
+    }
+    return 32
+}
+
+func repeatABIType(t abiType, n int) []abiType {
+    types := make([]abiType, n)
+    for i := range types {
+        types[i] = t
+    }
+    return types
+}
+
+/* ----- ENCODING ----- */
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// encodeABITuple encodes values as a tuple of types: static values in
+// the head, dynamic ones in the tail behind an offset. paths name the values
+// in errors.
+func encodeABITuple(types []abiType, values []interface{}, paths []string) ([]byte, error) {
+    headLength := 0
+    for _, t := range types {
+        headLength += t.headSize()
+    }
+
+    var head, tail []byte
+    for i, t := range types {
+        encoded, err := encodeABIValue(t, values[i], paths[i])
+        if err != nil {
+            return nil, err
+        }
+
+        if t.isDynamic() {
+            head = append(head, encodeABIUint(big.NewInt(int64(headLength + len(tail))))...)
+            tail = append(tail, encoded...)
+        } else {
+            head = append(head, encoded...)
+        }
+    }
+
+    return append(head, tail...), nil
+}
+
+func encodeABIValue(t abiType, value interface{}, path string) ([]byte, error) {
+    invalid := &ABIArgumentError{path, t.String()}
+
+    switch t.kind {
+    case abiUint, abiInt:
+        n, ok := abiIntValue(value)
+        if !ok || !fitsABIInt(t, n) {
+            return nil, invalid
+        }
+        if n.Sign() < 0 {
+            n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+        }
+        return encodeABIUint(n), nil
+    case abiAddress:
+        s, ok := value.(string)
+        if !ok || !isHexAddress(s) {
+            return nil, invalid
+        }
+        data, _ := hex.DecodeString(s[2:])
+        return leftPad32(data), nil
+    case abiBool:
+        b, ok := value.(bool)
+        if !ok {
+            return nil, invalid
+        }
+        if b {
+            return encodeABIUint(big.NewInt(1)), nil
+        }
+        return encodeABIUint(big.NewInt(0)), nil
+    case abiFixedBytes:
+        data, ok := abiBytesValue(value)
+        if !ok || len(data) != t.size {
+            return nil, invalid
+        }
+        return rightPad32(data), nil
+    case abiBytes, abiString:
+        var data []byte
+        ok := false
+        if t.kind == abiBytes {
+            data, ok = abiBytesValue(value)
+        } else if s, isString := value.(string); isString {
+            data, ok = []byte(s), true
+        }
+        if !ok {
+            return nil, invalid
+        }
+        return append(encodeABIUint(big.NewInt(int64(len(data)))), rightPad32(data)...), nil
+    case abiSlice, abiArray:
+        items, ok := value.([]interface{})
+        if !ok || t.kind == abiArray && len(items) != t.size {
+            return nil, invalid
+        }
+
+        paths := make([]string, len(items))
+        for i := range items {
+            paths[i] = path + "[" + strconv.Itoa(i) + "]"
+        }
+
+        encoded, err := encodeABITuple(repeatABIType(*t.elem, len(items)), items, paths)
+        if err != nil {
+            return nil, err
+        }
+        if t.kind == abiSlice {
+            encoded = append(encodeABIUint(big.NewInt(int64(len(items)))), encoded...)
+        }
+        return encoded, nil
+    }
+
+    // Tuples are given as a list in order, or as an object by field name.
+    items, ok := value.([]interface{})
+    if fields, isObject := value.(map[string]interface{}); isObject {
+        items, ok = tupleFieldValues(fields, t.names)
+    }
+    if !ok || len(items) != len(t.components) {
+        return nil, invalid
+    }
+
+    paths := make([]string, len(t.names))
+    for i, name := range t.names {
+        paths[i] = path + "." + name
+    }
+    return encodeABITuple(t.components, items, paths)
+}
+
+// tupleFieldValues orders the fields of a tuple given as an object.
+func tupleFieldValues(fields map[string]interface{}, names []string) ([]interface{}, bool) {
+    if len(fields) != len(names) {
+        return nil, false
+    }
+
+    values := make([]interface{}, len(names))
+    for i, name := range names {
+        value, ok := fields[name]
+        if !ok {
+            return nil, false
+        }
+        values[i] = value
+    }
+    return values, true
+}
+
+// abiIntValue reads an integer given as a JSON number or as a decimal or
+// 0x prefixed hex string, possibly negative.
+func abiIntValue(value interface{}) (*big.Int, bool) {
+    var s string
+    switch v := value.(type) {
+    case json.Number:
+        s = v.String()
+    case string:
+        s = v
+    default:
+        return nil, false
+    }
+
+    negative := strings.HasPrefix(s, "-")
+    s = strings.TrimPrefix(s, "-")
+
+    base := 10
+    if strings.HasPrefix(s, "0x") {
+        base = 16
+        s = s[2:]
+    }
+    if s == "" || strings.HasPrefix(s, "+") {
+        return nil, false
+    }
+
+    n, ok := new(big.Int).SetString(s, base)
+    if !ok {
+        return nil, false
+    }
+    if negative {
+        n.Neg(n)
+    }
+    return n, true
+}
+
+func fitsABIInt(t abiType, n *big.Int) bool {
+    if t.kind == abiUint {
+        return n.Sign() >= 0 && n.BitLen() <= t.size
+    }
+
+    limit := new(big.Int).Lsh(big.NewInt(1), uint(t.size - 1))
+    return n.Cmp(limit) < 0 && n.Cmp(new(big.Int).Neg(limit)) >= 0
+}
+
+func abiBytesValue(value interface{}) ([]byte, bool) {
+    s, ok := value.(string)
+    if !ok || !strings.HasPrefix(s, "0x") {
+        return nil, false
+    }
+
+    data, err := hex.DecodeString(s[2:])
+    return data, err == nil
+}
+
+func encodeABIUint(n *big.Int) []byte {
+    return leftPad32(n.Bytes())
+}
+
+func leftPad32(data []byte) []byte {
+    return append(make([]byte, 32 - len(data)), data...)
+}
+
+func rightPad32(data []byte) []byte {
+    if len(data) % 32 == 0 {
+        return data
+    }
+    return append(data, make([]byte, 32 - len(data) % 32)...)
+}
+
+/* ----- DECODING ----- */
+
+// decodeABITuple decodes a tuple of types from data, where offsets of
+// dynamic values are relative to the start of data.
+//
+// Offsets may point several values at the same data, so decoding is given a
+// budget: every value decoded, and every word of bytes and strings, spends
+// one of abiDecodeBudget per word of data. A value spans at least one word
+// of its own and sits at most maxABIDepth tuples and arrays deep, so no
+// honest encoding runs out.
+func decodeABITuple(types []abiType, data []byte) ([]interface{}, error) {
+    budget := abiDecodeBudget * ((len(data) + 31) / 32)
+    return decodeABIFields(types, data, &budget)
+}
+
+const abiDecodeBudget int = maxABIDepth + 1
+
+func decodeABIFields(types []abiType, data []byte, budget *int) ([]interface{}, error) {
+    values := make([]interface{}, len(types))
+    position := 0
+    for i, t := range types {
+        if position + t.headSize() > len(data) {
+            return nil, ErrDecodingABI
+        }
+
+        field := data[position:]
+        if t.isDynamic() {
+            offset, ok := decodeABIOffset(data[position:position + 32], len(data))
+            if !ok {
+                return nil, ErrDecodingABI
+            }
+            field = data[offset:]
+        }
+
+        value, err := decodeABIValue(t, field, budget)
+        if err != nil {
+            return nil, err
+        }
+
+        values[i] = value
+        position += t.headSize()
+    }
+
+    return values, nil
+}
+
+func decodeABIValue(t abiType, data []byte, budget *int) (interface{}, error) {
+    if len(data) < 32 && t.kind != abiTuple && t.kind != abiArray {
+        return nil, ErrDecodingABI
+    }
+    if *budget <= 0 {
+        return nil, ErrDecodingABI
+    }
+    *budget--
+
+    switch t.kind {
+    case abiUint:
+        return new(big.Int).SetBytes(data[:32]).String(), nil
+    case abiInt:
+        n := new(big.Int).SetBytes(data[:32])
+        if data[0] & 0x80 != 0 {
+            n.Sub(n, new(big.Int).Lsh(big.NewInt(1), 256))
+        }
+        return n.String(), nil
+    case abiAddress:
+        return checksumAddress(data[12:32]), nil
+    case abiBool:
+        return data[31] == 1, nil
+    case abiFixedBytes:
+        return "0x" + hex.EncodeToString(data[:t.size]), nil
+    case abiBytes, abiString:
+        length, ok := decodeABIOffset(data[:32], len(data) - 32)
+        if !ok || 32 + length > len(data) {
+            return nil, ErrDecodingABI
+        }
+        *budget -= (length + 31) / 32
+        if *budget < 0 {
+            return nil, ErrDecodingABI
+        }
+
+        content := data[32:32 + length]
+        if t.kind == abiBytes {
+            return "0x" + hex.EncodeToString(content), nil
+        }
+        if !utf8.Valid(content) {
+            return nil, ErrDecodingABI
+        }
+        return string(content), nil
+    case abiSlice:
+        length, ok := decodeABIOffset(data[:32], (len(data) - 32) / 32)
+        if !ok || length > *budget {
+            return nil, ErrDecodingABI
+        }
+        return decodeABIFields(repeatABIType(*t.elem, length), data[32:], budget)
+    case abiArray:
+        if t.size * t.elem.headSize() > len(data) || t.size > *budget {
+            return nil, ErrDecodingABI
+        }
+        return decodeABIFields(repeatABIType(*t.elem, t.size), data, budget)
+    }
+
+    values, err := decodeABIFields(t.components, data, budget)
+    if err != nil {
+        return nil, err
+    }
+
+    named := map[string]interface{}{}
+    for i, name := range t.names {
+        named[name] = values[i]
+    }
+    return named, nil
+}
+
+// decodeABIString decodes data holding a single ABI encoded string.
+func decodeABIString(data []byte) (string, bool) {
+    values, err := decodeABITuple([]abiType{{kind: abiString}}, data)
+    if err != nil {
+        return "", false
+    }
+    return values[0].(string), true
+}
+
+// decodeABIOffset reads a 32 byte offset or length no larger than max.
+func decodeABIOffset(word []byte, max int) (int, bool) {
+    n := new(big.Int).SetBytes(word)
+    if !n.IsInt64() || n.Int64() > int64(max) {
+        return 0, false
+    }
+    return int(n.Int64()), true
+}
+
+/* ----- HASHING ----- */
+func keccak256(data []byte) []byte {
+    hash := sha3.NewLegacyKeccak256()
+    hash.Write(data)
+    return hash.Sum(nil)
+}
+
+// checksumAddress formats a 20 byte address with the mixed case checksum of
+// EIP-55.
+func checksumAddress(address []byte) string {
+    lower := hex.EncodeToString(address)
+    hash := hex.EncodeToString(keccak256([]byte(lower)))
+
+    checksummed := []byte(lower)
+    for i, c := range checksummed {
+        if c >= 'a' && hash[i] >= '8' {
+            checksummed[i] = c - 'a' + 'A'
+        }
+    }
+    return "0x" + string(checksummed)
+}
\ No newline at end of file
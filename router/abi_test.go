@@ -0,0 +1,152 @@
+package router
+
+import (
+    "encoding/json"
+    "io/ioutil"
+    "math/big"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func TestParseABIRejectsOversizedTypes(t *testing.T) {
+    deep := `[{"name":"x","type":"uint256"}]`
+    for i := 0; i <= maxABIDepth; i++ {
+        deep = `[{"name":"x","type":"tuple","components":` + deep + `}]`
+    }
+
+    types := []string{
+        `[{"name":"x","type":"uint256[576460752303423488]"}]`,
+        `[{"name":"x","type":"uint256[65537]"}]`,
+        `[{"name":"x","type":"uint256[65536][65536]"}]`,
+        `[{"name":"x","type":"uint8` + strings.Repeat("[1]", maxABIDepth + 1) + `"}]`,
+        deep,
+    }
+
+    for _, inputs := range types {
+        _, err := ParseABI([]byte(`[{"type":"function","name":"f","inputs":` + inputs + `,"outputs":[]}]`))
+        if err != ErrInvalidABI {
+            t.Errorf("%.60s: got %v, want ErrInvalidABI", inputs, err)
+        }
+    }
+}
+
+func TestDecodeABIRejectsArraysLongerThanData(t *testing.T) {
+    abi, err := ParseABI([]byte(`[{"type":"function","name":"f","inputs":[],"outputs":[{"name":"x","type":"string[65536]"}]}]`))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    fn, err := abi.Function("f", 0)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    data := make([]byte, 64)
+    data[31] = 32
+    if _, err = fn.DecodeOutputs(data); err != ErrDecodingABI {
+        t.Errorf("got %v, want ErrDecodingABI", err)
+    }
+}
+
+func TestABIsFromDirectoryCannotBeReplaced(t *testing.T) {
+    dir, err := ioutil.TempDir("", "abis")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    erc20 := []byte(`[{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}]`)
+    other := []byte(`[{"type":"function","name":"f","inputs":[],"outputs":[]}]`)
+    if err = ioutil.WriteFile(filepath.Join(dir, "erc20.json"), erc20, 0644); err != nil {
+        t.Fatal(err)
+    }
+
+    registry := NewABIRegistry()
+    if err = registry.LoadDir(dir); err != nil {
+        t.Fatal(err)
+    }
+
+    if _, err = registry.Register("erc20", other); err != ErrFixedABI {
+        t.Errorf("replacing a loaded ABI got %v, want ErrFixedABI", err)
+    }
+    if abi, _ := registry.Get("erc20"); len(abi.Functions["balanceOf"]) != 1 {
+        t.Error("loaded ABI was replaced")
+    }
+
+    for _, data := range [][]byte{erc20, other} {
+        if _, err = registry.Register("mine", data); err != nil {
+            t.Errorf("registering over a registered ABI got %v", err)
+        }
+    }
+    if abi, _ := registry.Get("mine"); len(abi.Functions["f"]) != 1 {
+        t.Error("registered ABI was not replaced")
+    }
+}
+
+func TestDecodeABIRejectsSharedOffsets(t *testing.T) {
+    abi, err := ParseABI([]byte(`[{"type":"function","name":"f","inputs":[],"outputs":[{"name":"x","type":"uint256[][][]"}]}]`))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    fn, err := abi.Function("f", 0)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Every element of both outer arrays points at the same inner array,
+    // which would decode to a million numbers from 10 KB.
+    word := func(n int) []byte {
+        return leftPad32(big.NewInt(int64(n)).Bytes())
+    }
+    const length = 100
+    data := word(32)
+    for level := 0; level < 3; level++ {
+        data = append(data, word(length)...)
+        for i := 0; i < length; i++ {
+            if level < 2 {
+                data = append(data, word(length * 32)...)
+            } else {
+                data = append(data, word(i)...)
+            }
+        }
+    }
+
+    if _, err = fn.DecodeOutputs(data); err != ErrDecodingABI {
+        t.Errorf("got %v, want ErrDecodingABI", err)
+    }
+
+
+    deep := `[{"name":"x","type":"uint256"}]`
+    arg := `1`
+    for i := 1; i < maxABIDepth; i++ {
+        deep = `[{"name":"x","type":"tuple","components":` + deep + `}]`
+        arg = `[` + arg + `]`
+    }
+
+    honest := []struct {
+        outputs string
+        arg string
+    }{
+        {`[{"name":"x","type":"uint256[][][]"}]`, `[[[1,2],[3]],[[]]]`},
+        {`[{"name":"x","type":"string[]"}]`, `["a","","` + strings.Repeat("b", 100) + `"]`},
+        {deep, arg},
+    }
+    for _, c := range honest {
+        abi, err := ParseABI([]byte(`[{"type":"function","name":"f","inputs":` + c.outputs + `,"outputs":` + c.outputs + `}]`))
+        if err != nil {
+            t.Fatal(err)
+        }
+        fn, _ := abi.Function("f", 1)
+
+        data, err := fn.EncodeCall([]json.RawMessage{json.RawMessage(c.arg)})
+        if err != nil {
+            t.Fatal(err)
+        }
+        if _, err = fn.DecodeOutputs(data[4:]); err != nil {
+            t.Errorf("%.40s: got %v decoding %s", c.outputs, err, c.arg)
+        }
+    }
+}
\ No newline at end of file
@@ -0,0 +1,149 @@
+package router
+
+import (
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/base64"
+    "encoding/hex"
+    "encoding/json"
+    "io/ioutil"
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+)
+
+// Geth rejects tokens whose iat is more than 60 seconds off, so tokens are
+// minted with a short lifetime and refreshed well before that.
+const jwtTokenLifetime time.Duration = 60 * time.Second
+const jwtTokenRefresh time.Duration = 30 * time.Second
+const jwtSecretLength int = 32
+
+// UpstreamAuth describes how to authenticate against one upstream node.
+// Headers are sent as is; Username/Password add basic auth and JWTSecretFile
+// adds a bearer token signed with the hex encoded secret in that file, as
+// expected by geth's --authrpc.jwtsecret.
+type UpstreamAuth struct {
+    Headers map[string]string `json:"headers,omitempty"`
+    Username string `json:"username,omitempty"`
+    Password string `json:"password,omitempty"`
+    JWTSecretFile string `json:"jwtSecretFile,omitempty"`
+}
+
+func (a UpstreamAuth) isEmpty() bool {
+    return len(a.Headers) == 0 && a.Username == "" && a.Password == "" && a.JWTSecretFile == ""
+}
+
+type jwtHeader struct {
+    Alg string `json:"alg"`
+    Typ string `json:"typ"`
+}
+
+type jwtClaims struct {
+    Iat int64 `json:"iat"`
+    Exp int64 `json:"exp"`
+}
+
+/* ----- AUTHENTICATOR ----- */
+
+// Authenticator produces the headers an upstream request or WebSocket
+// handshake has to carry.
+type Authenticator struct {
+    auth UpstreamAuth
+    jwtSecret []byte
+
+    mu sync.Mutex
+    token string
+    tokenIssued time.Time
+}
+
+func NewAuthenticator(auth UpstreamAuth) (*Authenticator, error) {
+    a := &Authenticator{auth: auth}
+
+    if auth.JWTSecretFile == "" {
+        return a, nil
+    }
+
+    if auth.Username != "" || auth.Password != "" {
+        return nil, ErrConflictingUpstreamAuth
+    }
+
+    secret, err := readJWTSecret(auth.JWTSecretFile)
+    if err != nil {
+        return nil, err
+    }
+    a.jwtSecret = secret
+
+    return a, nil
+}
+
+// Header returns a fresh copy of the authentication headers.
+func (a *Authenticator) Header() http.Header {
+    header := http.Header{}
+    for name, value := range a.auth.Headers {
+        header.Set(name, value)
+    }
+
+    if a.auth.Username != "" || a.auth.Password != "" {
+        credentials := a.auth.Username + ":" + a.auth.Password
+        header.Set("Authorization", "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials)))
+    }
+
+    if a.jwtSecret != nil {
+        header.Set("Authorization", "Bearer " + a.jwtToken())
+    }
+
+    return header
+}
+
+// apply adds the authentication headers to req.
+func (a *Authenticator) apply(req *http.Request) {
+    for name, values := range a.Header() {
+        req.Header[name] = values
+    }
+}
+
+// jwtToken returns the current token, minting a new one when it is about to
+// expire.
+func (a *Authenticator) jwtToken() string {
+    a.mu.Lock()
+    defer a.mu.Unlock()
+
+    now := time.Now()
+    if a.token == "" || now.Sub(a.tokenIssued) >= jwtTokenRefresh {
+        a.token = signJWT(a.jwtSecret, jwtClaims{
+            Iat: now.Unix(),
+            Exp: now.Add(jwtTokenLifetime).Unix(),
+        })
+        a.tokenIssued = now
+    }
+
+    return a.token
+}
+
+func signJWT(secret []byte, claims jwtClaims) string {
+    headerJson, _ := json.Marshal(jwtHeader{"HS256", "JWT"})
+    claimsJson, _ := json.Marshal(claims)
+
+    signingInput := base64.RawURLEncoding.EncodeToString(headerJson) + "." + base64.RawURLEncoding.EncodeToString(claimsJson)
+
+    mac := hmac.New(sha256.New, secret)
+    mac.Write([]byte(signingInput))
+
+    return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func readJWTSecret(path string) ([]byte, error) {
+    data, err := ioutil.ReadFile(path)
+    if err != nil {
+        return nil, ErrReadingJWTSecret
+    }
+
+    secretHex := strings.TrimPrefix(strings.TrimSpace(string(data)), "0x")
+    secret, err := hex.DecodeString(secretHex)
+    if err != nil || len(secret) != jwtSecretLength {
+        return nil, ErrInvalidJWTSecret
+    }
+
+    return secret, nil
+}
\ No newline at end of file
@@ -0,0 +1,131 @@
+package router
+
+import (
+    "context"
+    "encoding/hex"
+    "encoding/json"
+    "sort"
+)
+
+// CallContractRequest calls Function of the contract at Contract with Args,
+// each a JSON value. The ABI is sent inline, or named by ABIName once
+// registered. Block is a block number, hash or tag, latest when empty.
+type CallContractRequest struct {
+    Contract string `json:"contract"`
+    ABI json.RawMessage `json:"abi,omitempty"`
+    ABIName string `json:"abiName,omitempty"`
+    Function string `json:"function"`
+    Args []json.RawMessage `json:"args"`
+    From string `json:"from,omitempty"`
+    Block string `json:"block,omitempty"`
+}
+
+// ContractCallResult holds the decoded return values of a contract call,
+// next to the raw return data.
+type ContractCallResult struct {
+    Contract string `json:"contract"`
+    Function string `json:"function"`
+    Block string `json:"block"`
+    Outputs interface{} `json:"outputs"`
+    ReturnData string `json:"returnData"`
+}
+
+type RegisteredABI struct {
+    Name string `json:"name"`
+    Functions []string `json:"functions"`
+}
+
+// CallContract encodes the call from the ABI, runs it with eth_call and
+// decodes what the function returns.
+func (svc EthServiceImp) CallContract(ctx context.Context, req CallContractRequest) (interface{}, error) {
+    if !isHexAddress(req.Contract) || req.From != "" && !isHexAddress(req.From) {
+        return nil, ErrInvalidAddress
+    }
+
+    abi, err := svc.contractABI(req)
+    if err != nil {
+        return nil, err
+    }
+
+    fn, err := abi.Function(req.Function, len(req.Args))
+    if err != nil {
+        return nil, err
+    }
+
+    calldata, err := fn.EncodeCall(req.Args)
+    if err != nil {
+        return nil, err
+    }
+
+    blockParam, err := normalizeBlockParam(req.Block)
+    if err != nil {
+        return nil, err
+    }
+
+    blockLabel, ok := blockParam.(string)
+    if !ok {
+        blockLabel = req.Block
+    }
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructCallRequest(CallArgs{From: req.From, To: req.Contract, Data: "0x" + hex.EncodeToString(calldata)}, blockParam)
+
+    var returnData string
+    err = callRPC(ctx, svc.client, rpcReq, &returnData)
+    if err != nil {
+        return nil, err
+    }
+
+    if !isHexData(returnData, (len(returnData) - 2) / 2) {
+        return nil, ErrParsingJSON
+    }
+    data, _ := hex.DecodeString(returnData[2:])
+
+    outputs, err := fn.DecodeOutputs(data)
+    if err != nil {
+        return nil, err
+    }
+
+    return ContractCallResult{
+        Contract: req.Contract,
+        Function: fn.Signature(),
+        Block: blockLabel,
+        Outputs: outputs,
+        ReturnData: returnData,
+    }, nil
+}
+
+// RegisterABI stores an ABI for later calls naming it. Registering takes a
+// debug token, as the ABIs are shared by every caller.
+func (svc EthServiceImp) RegisterABI(ctx context.Context, name string, data json.RawMessage) (interface{}, error) {
+    err := svc.Debug.authorize(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    abi, err := svc.ABIs.Register(name, data)
+    if err != nil {
+        return nil, err
+    }
+
+    functions := abi.Signatures()
+    sort.Strings(functions)
+
+    return RegisteredABI{name, functions}, nil
+}
+
+func (svc EthServiceImp) contractABI(req CallContractRequest) (*ABI, error) {
+    if len(req.ABI) > 0 {
+        return ParseABI(req.ABI)
+    }
+
+    if req.ABIName == "" {
+        return nil, ErrMissingABI
+    }
+
+    abi, ok := svc.ABIs.Get(req.ABIName)
+    if !ok {
+        return nil, ErrUnknownABI
+    }
+    return abi, nil
+}
\ No newline at end of file
@@ -0,0 +1,864 @@
+package router_test
+
+import (
+    "context"
+    "encoding/json"
+    "io"
+    "io/ioutil"
+    "net"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "strconv"
+    "strings"
+    "sync/atomic"
+    "testing"
+    "time"
+
+    "github.com/go-kit/kit/endpoint"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "github.com/herrjemand/gethGoKitRPCMicroService/fakegeth"
+    pb "github.com/herrjemand/gethGoKitRPCMicroService/proto"
+    "github.com/herrjemand/gethGoKitRPCMicroService/router"
+)
+
+// fastRetryPolicy retries like the default policy without the waiting.
+var fastRetryPolicy = router.RetryPolicy{
+    MaxAttempts: 3,
+    InitialBackoff: time.Millisecond,
+    MaxBackoff: 5 * time.Millisecond,
+    Multiplier: 2,
+}
+
+// fakeNode is a fakegeth server on a local port. Requests counts the HTTP
+// requests it got, each of which may carry a batch of calls.
+type fakeNode struct {
+    Chain *fakegeth.Chain
+    Server *fakegeth.Server
+    URL string
+
+    requests int32
+    http *httptest.Server
+}
+
+func newFakeNode(config fakegeth.ChainConfig) *fakeNode {
+    node := &fakeNode{Chain: fakegeth.NewChain(config)}
+    node.Server = fakegeth.NewServer(node.Chain)
+    node.http = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        atomic.AddInt32(&node.requests, 1)
+        node.Server.ServeHTTP(w, r)
+    }))
+    node.URL = node.http.URL
+    return node
+}
+
+func (node *fakeNode) Requests() int {
+    return int(atomic.LoadInt32(&node.requests))
+}
+
+func (node *fakeNode) Close() {
+    node.http.Close()
+}
+
+func serveHTTP(svc router.EthService, middlewares ...endpoint.Middleware) *httptest.Server {
+    return httptest.NewServer(router.GenerateHTTPRouter(svc, middlewares...).(http.Handler))
+}
+
+// serveGRPC runs the gRPC endpoints of svc on a local port until the
+// returned function is called.
+func serveGRPC(t *testing.T, svc router.EthService, middlewares ...endpoint.Middleware) (pb.EthGRPCClient, func()) {
+    listener, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    server := grpc.NewServer()
+    pb.RegisterEthGRPCServer(server, router.GetGethGRPCEndpoints(context.Background(), svc, middlewares...))
+    go server.Serve(listener)
+
+    conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+    if err != nil {
+        server.Stop()
+        t.Fatal(err)
+    }
+
+    return pb.NewEthGRPCClient(conn), func() {
+        conn.Close()
+        server.Stop()
+    }
+}
+
+// getJSON decodes the body of a GET into v, when given, and returns the
+// status code.
+func getJSON(t *testing.T, url string, v interface{}) int {
+    resp, err := http.Get(url)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer resp.Body.Close()
+
+    body, err := ioutil.ReadAll(resp.Body)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if v != nil && resp.StatusCode == http.StatusOK {
+        if err = json.Unmarshal(body, v); err != nil {
+            t.Fatal(url + ": " + err.Error() + ": " + string(body))
+        }
+    }
+    return resp.StatusCode
+}
+
+func TestBlockTransactionsAreBatched(t *testing.T) {
+    config := fakegeth.DefaultChainConfig
+    config.TxsPerBlock = 10
+    node := newFakeNode(config)
+    defer node.Close()
+
+    client, err := router.NewRPCClient(router.RPCClientConfig{Url: node.URL, MaxBatchSize: 4})
+    if err != nil {
+        t.Fatal(err)
+    }
+    svc := router.NewEthService(client)
+    server := serveHTTP(svc)
+    defer server.Close()
+
+    block, _ := node.Chain.BlockByNumber(3)
+    var txs router.TransactionResultsResponse
+    if code := getJSON(t, server.URL + "/getBlockHashTransactions/" + block.Hash, &txs); code != http.StatusOK {
+        t.Fatalf("status %d", code)
+    }
+
+    if len(txs.Transactions) != len(block.Transactions) {
+        t.Fatalf("got %d transactions, want %d", len(txs.Transactions), len(block.Transactions))
+    }
+    for i, tx := range txs.Transactions {
+        if tx.Hash != block.Transactions[i].Hash {
+            t.Errorf("transaction %d is %s, want %s", i, tx.Hash, block.Transactions[i].Hash)
+        }
+    }
+
+    // One count, three batches of at most four transactions and one batch
+    // of token metadata.
+    if node.Requests() != 5 {
+        t.Errorf("node got %d requests, want 5", node.Requests())
+    }
+    if calls := node.Server.Calls("eth_getTransactionByBlockHashAndIndex"); calls != 10 {
+        t.Errorf("node got %d transaction lookups, want 10", calls)
+    }
+
+    grpcClient, stop := serveGRPC(t, svc)
+    defer stop()
+
+    resp, err := grpcClient.GetTxsForBlockHash(context.Background(), &pb.GetTxsForBlockHashRequest{BlockHash: block.Hash})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(resp.Transactions) != len(block.Transactions) || resp.Transactions[9].Hash != block.Transactions[9].Hash {
+        t.Errorf("gRPC got %d transactions", len(resp.Transactions))
+    }
+}
+
+func TestPoolFailsOverToHealthyUpstream(t *testing.T) {
+    first := newFakeNode(fakegeth.DefaultChainConfig)
+    defer first.Close()
+    second := newFakeNode(fakegeth.DefaultChainConfig)
+    defer second.Close()
+
+    pool, err := router.NewPoolRPCClient([]router.RPCClientConfig{{Url: first.URL}, {Url: second.URL}})
+    if err != nil {
+        t.Fatal(err)
+    }
+    pool.Start()
+    defer pool.Close()
+
+    server := serveHTTP(router.NewEthService(pool))
+    defer server.Close()
+
+    block, _ := first.Chain.BlockByNumber(5)
+    if code := getJSON(t, server.URL + "/getBlockByHash/" + block.Hash, nil); code != http.StatusOK {
+        t.Fatalf("status %d", code)
+    }
+
+    preferred, other := first, second
+    if pool.UpstreamStatus().LastServedBy == second.URL {
+        preferred, other = second, first
+    }
+    preferred.Server.InjectFault(fakegeth.Fault{Drop: true})
+
+    var got router.Block
+    if code := getJSON(t, server.URL + "/getBlockByHash/" + block.Hash, &got); code != http.StatusOK {
+        t.Fatalf("status %d after failover", code)
+    }
+    if got.Hash != block.Hash {
+        t.Errorf("got block %s, want %s", got.Hash, block.Hash)
+    }
+
+    poolStatus := pool.UpstreamStatus()
+    if poolStatus.LastServedBy != other.URL {
+        t.Errorf("served by %s, want %s", poolStatus.LastServedBy, other.URL)
+    }
+    for _, upstream := range poolStatus.Upstreams {
+        if upstream.Url == preferred.URL && (upstream.Healthy || upstream.Failures != 1) {
+            t.Errorf("dropping upstream is %+v", upstream)
+        }
+    }
+}
+
+func TestPoolRetriesNullLookupsOnNodesAhead(t *testing.T) {
+    behind := newFakeNode(fakegeth.DefaultChainConfig)
+    defer behind.Close()
+    ahead := newFakeNode(fakegeth.DefaultChainConfig)
+    defer ahead.Close()
+    ahead.Chain.Mine(1)
+
+    // The node ahead answers slowly enough to rank after the one behind.
+    ahead.Server.InjectFault(fakegeth.Fault{Method: "eth_blockNumber", Times: 1, Delay: 300 * time.Millisecond})
+
+    pool, err := router.NewPoolRPCClient([]router.RPCClientConfig{{Url: behind.URL}, {Url: ahead.URL}})
+    if err != nil {
+        t.Fatal(err)
+    }
+    pool.Start()
+    defer pool.Close()
+
+    server := serveHTTP(router.NewEthService(pool))
+    defer server.Close()
+
+    var got router.Block
+    if code := getJSON(t, server.URL + "/getBlockByNumber/17", &got); code != http.StatusOK {
+        t.Fatalf("status %d", code)
+    }
+    if got.Number != "0x11" {
+        t.Errorf("got block %s, want 0x11", got.Number)
+    }
+    if behind.Server.Calls("eth_getBlockByNumber") != 1 || ahead.Server.Calls("eth_getBlockByNumber") != 1 {
+        t.Errorf("lookups behind %d, ahead %d, want 1 each",
+            behind.Server.Calls("eth_getBlockByNumber"), ahead.Server.Calls("eth_getBlockByNumber"))
+    }
+}
+
+func TestRetryRecoversFromTransientFaults(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+
+    svc := router.NewEthService(router.NewRetryRPCClient(router.NewHTTPRPCClient(node.URL), fastRetryPolicy))
+    server := serveHTTP(svc)
+    defer server.Close()
+
+    block, _ := node.Chain.BlockByNumber(2)
+    url := server.URL + "/getBlockByHash/" + block.Hash
+
+    node.Server.InjectFault(fakegeth.Fault{Method: "eth_getBlockByHash", Times: 2, HTTPStatus: http.StatusServiceUnavailable})
+    if code := getJSON(t, url, nil); code != http.StatusOK {
+        t.Fatalf("status %d after two 503s", code)
+    }
+    if node.Requests() != 3 {
+        t.Errorf("node got %d requests, want 3", node.Requests())
+    }
+
+    node.Server.InjectFault(fakegeth.Fault{Method: "eth_getBlockByHash", Times: 1, Error: &fakegeth.Error{Code: -32005, Message: "rate limited"}})
+    if code := getJSON(t, url, nil); code != http.StatusOK {
+        t.Fatalf("status %d after a rate limit", code)
+    }
+
+    node.Server.InjectFault(fakegeth.Fault{Method: "eth_getBlockByHash", HTTPStatus: http.StatusServiceUnavailable})
+    before := node.Requests()
+    if code := getJSON(t, url, nil); code != http.StatusBadGateway {
+        t.Errorf("status %d once retries run out, want 502", code)
+    }
+    if node.Requests() - before != fastRetryPolicy.MaxAttempts {
+        t.Errorf("node got %d requests, want %d", node.Requests() - before, fastRetryPolicy.MaxAttempts)
+    }
+}
+
+func TestCircuitBreakerOpensAndRecovers(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+
+    breaker := router.NewCircuitBreaker(router.CircuitBreakerConfig{
+        FailureThreshold: 2,
+        OpenTimeout: 50 * time.Millisecond,
+        HalfOpenMaxRequests: 1,
+    })
+    svc := router.NewEthService(router.NewHTTPRPCClient(node.URL))
+    server := serveHTTP(svc, breaker.Middleware())
+    defer server.Close()
+
+    block, _ := node.Chain.BlockByNumber(2)
+    url := server.URL + "/getBlockByHash/" + block.Hash
+
+    node.Server.InjectFault(fakegeth.Fault{Drop: true})
+    for i := 0; i < 2; i++ {
+        if code := getJSON(t, url, nil); code != http.StatusServiceUnavailable {
+            t.Fatalf("status %d while dropping, want 503", code)
+        }
+    }
+    if breaker.State() != router.CircuitOpen {
+        t.Fatalf("breaker %s after two failures, want open", breaker.State())
+    }
+
+    before := node.Requests()
+    if code := getJSON(t, url, nil); code != http.StatusServiceUnavailable {
+        t.Errorf("status %d while open, want 503", code)
+    }
+    if node.Requests() != before {
+        t.Error("open breaker called the node")
+    }
+
+    node.Server.ClearFaults()
+    time.Sleep(60 * time.Millisecond)
+
+    // Invalid requests and missing blocks do not show the node is back.
+    if code := getJSON(t, server.URL + "/getBlockByHash/0x12", nil); code != http.StatusBadRequest {
+        t.Errorf("status %d for an invalid hash, want 400", code)
+    }
+    if code := getJSON(t, server.URL + "/getBlockByHash/0x" + block.Hash[4:] + "00", nil); code != http.StatusNotFound {
+        t.Errorf("status %d for a missing block, want 404", code)
+    }
+    if breaker.State() != router.CircuitHalfOpen {
+        t.Fatalf("breaker %s after unsuccessful trials, want half-open", breaker.State())
+    }
+
+    if code := getJSON(t, url, nil); code != http.StatusOK {
+        t.Fatalf("status %d for the trial, want 200", code)
+    }
+    if breaker.State() != router.CircuitClosed {
+        t.Fatalf("breaker %s after a successful trial, want closed", breaker.State())
+    }
+
+    // Callers giving up early do not count against the node.
+    grpcClient, stop := serveGRPC(t, svc, breaker.Middleware())
+    defer stop()
+
+    node.Server.InjectFault(fakegeth.Fault{Method: "eth_getBlockByHash", Delay: 200 * time.Millisecond})
+    for i := 0; i < 3; i++ {
+        ctx, cancel := context.WithTimeout(context.Background(), 20 * time.Millisecond)
+        _, err := grpcClient.GetBlock(ctx, &pb.GetBlockRequest{BlockHash: block.Hash})
+        cancel()
+        if status.Code(err) != codes.DeadlineExceeded {
+            t.Fatalf("got %v, want a deadline error", err)
+        }
+    }
+    if breaker.State() != router.CircuitClosed {
+        t.Errorf("breaker %s after caller deadlines, want closed", breaker.State())
+    }
+}
+
+func TestLogsArePaginated(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+    node.Server.MaxLogs = 5
+    node.Server.MaxLogsRange = 8
+
+    svc := router.NewEthService(router.NewHTTPRPCClient(node.URL))
+    server := serveHTTP(svc)
+    defer server.Close()
+
+    want := node.Chain.Logs(0, uint64(fakegeth.DefaultChainConfig.Blocks))
+
+    query := server.URL + "/getLogs?fromBlock=earliest&limit=7&topic0=" + fakegeth.TransferEventTopic
+    got := []router.Log{}
+    pages := 0
+    for cursor := ""; ; pages++ {
+        url := query
+        if cursor != "" {
+            url += "&cursor=" + cursor
+        }
+
+        var page router.LogsPage
+        if code := getJSON(t, url, &page); code != http.StatusOK {
+            t.Fatalf("status %d for page %d", code, pages)
+        }
+        got = append(got, page.Logs...)
+
+        if page.NextCursor == "" {
+            break
+        }
+        cursor = page.NextCursor
+    }
+
+    if len(got) != len(want) {
+        t.Fatalf("got %d logs in %d pages, want %d", len(got), pages, len(want))
+    }
+    if pages < 2 {
+        t.Errorf("got all logs in %d pages", pages)
+    }
+    for i := range got {
+        if got[i].TransactionHash != want[i].TransactionHash || got[i].LogIndex != want[i].LogIndex {
+            t.Fatalf("log %d is %s/%s, want %s/%s", i, got[i].TransactionHash, got[i].LogIndex, want[i].TransactionHash, want[i].LogIndex)
+        }
+    }
+
+    grpcClient, stop := serveGRPC(t, svc)
+    defer stop()
+
+    stream, err := grpcClient.StreamLogs(context.Background(), &pb.GetLogsRequest{
+        FromBlock: "earliest",
+        Limit: 7,
+        Topics: []*pb.LogTopics{{Alternatives: []string{fakegeth.TransferEventTopic}}},
+    })
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    streamed := 0
+    for {
+        resp, err := stream.Recv()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            t.Fatal(err)
+        }
+        streamed += len(resp.Logs)
+    }
+    if streamed != len(want) {
+        t.Errorf("streamed %d logs, want %d", streamed, len(want))
+    }
+}
+
+func TestLogsPagesAreTrimmedAtBlocks(t *testing.T) {
+    const logsPerBlock = 4000
+
+    // The node holds logsPerBlock logs in each of the first three blocks
+    // and leaves the number of the pending block null.
+    node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        var req struct {
+            Id json.RawMessage `json:"id"`
+            Method string `json:"method"`
+            Params []json.RawMessage `json:"params"`
+        }
+        json.NewDecoder(r.Body).Decode(&req)
+
+        result := "null"
+        switch req.Method {
+        case "eth_getBlockByNumber":
+            result = `{"number":null,"hash":null}`
+        case "eth_getLogs":
+            var filter router.LogFilter
+            json.Unmarshal(req.Params[0], &filter)
+            from, _ := strconv.ParseInt(filter.FromBlock[2:], 16, 64)
+            to, _ := strconv.ParseInt(filter.ToBlock[2:], 16, 64)
+
+            logs := []string{}
+            for block := from; block <= to && block <= 3; block++ {
+                for i := 0; i < logsPerBlock; i++ {
+                    logs = append(logs, `{"blockNumber":"0x` + strconv.FormatInt(block, 16) + `","logIndex":"0x` + strconv.FormatInt(int64(i), 16) + `"}`)
+                }
+            }
+            result = "[" + strings.Join(logs, ",") + "]"
+        }
+        w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.Id) + `,"result":` + result + `}`))
+    }))
+    defer node.Close()
+
+    svc := router.NewEthService(router.NewHTTPRPCClient(node.URL))
+    server := serveHTTP(svc)
+    defer server.Close()
+
+    var page router.LogsPage
+    if code := getJSON(t, server.URL + "/getLogs?fromBlock=0x1&toBlock=0xa&limit=10000", &page); code != http.StatusOK {
+        t.Fatalf("status %d", code)
+    }
+    if len(page.Logs) != 2 * logsPerBlock || page.ToBlock != "0x2" || page.NextCursor == "" {
+        t.Errorf("got %d logs up to block %s, want the %d logs of blocks 1 and 2", len(page.Logs), page.ToBlock, 2 * logsPerBlock)
+    }
+
+    if code := getJSON(t, server.URL + "/getLogs?fromBlock=0x1&toBlock=pending", nil); code != http.StatusUnprocessableEntity {
+        t.Errorf("got status %d for a pending block without a number, want %d", code, http.StatusUnprocessableEntity)
+    }
+}
+
+func TestFaultsMapToStatusCodes(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+
+    svc := router.NewEthService(router.NewHTTPRPCClient(node.URL))
+    server := serveHTTP(svc)
+    defer server.Close()
+    grpcClient, stop := serveGRPC(t, svc)
+    defer stop()
+
+    block, _ := node.Chain.BlockByNumber(1)
+
+    cases := []struct {
+        name string
+        fault fakegeth.Fault
+        httpStatus int
+        grpcCode codes.Code
+    }{
+        {"invalid params", fakegeth.Fault{Error: &fakegeth.Error{Code: -32602, Message: "invalid argument 0"}}, http.StatusBadRequest, codes.InvalidArgument},
+        {"node error", fakegeth.Fault{Error: &fakegeth.Error{Code: -32000, Message: "internal"}}, http.StatusBadGateway, codes.Internal},
+        {"rate limited", fakegeth.Fault{Error: &fakegeth.Error{Code: -32005, Message: "limit exceeded"}}, http.StatusTooManyRequests, codes.ResourceExhausted},
+        {"too many results", fakegeth.Fault{Error: &fakegeth.Error{Code: -32005, Message: "query returned more than 10000 results"}}, http.StatusUnprocessableEntity, codes.FailedPrecondition},
+        {"null result", fakegeth.Fault{NullResult: true}, http.StatusNotFound, codes.NotFound},
+        {"http status", fakegeth.Fault{HTTPStatus: http.StatusServiceUnavailable}, http.StatusBadGateway, codes.Unavailable},
+        {"dropped connection", fakegeth.Fault{Drop: true}, http.StatusServiceUnavailable, codes.Unavailable},
+    }
+
+    for _, c := range cases {
+        c.fault.Method = "eth_getBlockByHash"
+        c.fault.Times = 1
+        node.Server.InjectFault(c.fault)
+        if code := getJSON(t, server.URL + "/getBlockByHash/" + block.Hash, nil); code != c.httpStatus {
+            t.Errorf("%s: HTTP status %d, want %d", c.name, code, c.httpStatus)
+        }
+
+        node.Server.InjectFault(c.fault)
+        _, err := grpcClient.GetBlock(context.Background(), &pb.GetBlockRequest{BlockHash: block.Hash})
+        if status.Code(err) != c.grpcCode {
+            t.Errorf("%s: gRPC code %s, want %s", c.name, status.Code(err), c.grpcCode)
+        }
+    }
+
+    if code := getJSON(t, server.URL + "/getBlockByHash/" + block.Hash, nil); code != http.StatusOK {
+        t.Errorf("status %d once the faults are used up", code)
+    }
+}
+
+func TestMetricsAreServedApartWithoutCmdline(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+    server := serveHTTP(router.NewEthService(router.NewHTTPRPCClient(node.URL)))
+    defer server.Close()
+
+    if code := getJSON(t, server.URL + "/debug/vars", nil); code != http.StatusNotFound {
+        t.Errorf("API router served metrics with status %d", code)
+    }
+
+    metrics := httptest.NewServer(router.MetricsHandler())
+    defer metrics.Close()
+
+    var vars map[string]json.RawMessage
+    if code := getJSON(t, metrics.URL, &vars); code != http.StatusOK {
+        t.Fatalf("status %d", code)
+    }
+    if _, ok := vars["cmdline"]; ok {
+        t.Error("metrics published cmdline")
+    }
+    if _, ok := vars["memstats"]; !ok {
+        t.Error("metrics left out memstats")
+    }
+}
+
+func TestRegisteringABIsTakesATokenAndIsBounded(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+
+    svc := router.NewEthService(router.NewHTTPRPCClient(node.URL))
+    svc.Debug.Tokens = []string{"secret"}
+    svc.ABIs.MaxABIs = 1
+    server := serveHTTP(svc)
+    defer server.Close()
+
+    abi := `[{"type":"function","name":"f","inputs":[],"outputs":[]}]`
+    post := func(path string, token string, body string) int {
+        req, err := http.NewRequest("POST", server.URL + path, strings.NewReader(body))
+        if err != nil {
+            t.Fatal(err)
+        }
+        if token != "" {
+            req.Header.Set(router.DebugTokenHeader, token)
+        }
+
+        resp, err := http.DefaultClient.Do(req)
+        if err != nil {
+            t.Fatal(err)
+        }
+        resp.Body.Close()
+        return resp.StatusCode
+    }
+
+    cases := []struct {
+        name string
+        path string
+        token string
+        body string
+        status int
+    }{
+        {"no token", "/abis/first", "", abi, http.StatusUnauthorized},
+        {"wrong token", "/abis/first", "guess", abi, http.StatusForbidden},
+        {"first", "/abis/first", "secret", abi, http.StatusOK},
+        {"over the cap", "/abis/second", "secret", abi, http.StatusInsufficientStorage},
+        {"replacing", "/abis/first", "secret", abi, http.StatusOK},
+        {"huge ABI", "/abis/first", "secret", "[" + strings.Repeat(abi + ",", int(router.MaxRequestBodySize) / len(abi)) + abi + "]", http.StatusRequestEntityTooLarge},
+        {"huge call", "/callContract", "", `{"abi":` + strings.Repeat(" ", int(router.MaxRequestBodySize)) + `}`, http.StatusRequestEntityTooLarge},
+    }
+
+    for _, c := range cases {
+        if status := post(c.path, c.token, c.body); status != c.status {
+            t.Errorf("%s: got status %d, want %d", c.name, status, c.status)
+        }
+    }
+}
+
+func TestStrictReplayDoesNotServeUnrecordedCalls(t *testing.T) {
+    recording, err := ioutil.TempFile("", "recording")
+    if err != nil {
+        t.Fatal(err)
+    }
+    recording.Close()
+    defer os.Remove(recording.Name())
+
+    replay, err := router.NewReplayRPCClient(recording.Name())
+    if err != nil {
+        t.Fatal(err)
+    }
+    replay.Strict = true
+
+    svc := router.NewEthService(replay)
+    server := serveHTTP(svc)
+    defer server.Close()
+    grpcClient, stop := serveGRPC(t, svc)
+    defer stop()
+
+    hash := "0x" + strings.Repeat("ab", 32)
+    if code := getJSON(t, server.URL + "/getBlockByHash/" + hash, nil); code != http.StatusNotImplemented {
+        t.Errorf("got HTTP status %d, want %d", code, http.StatusNotImplemented)
+    }
+
+    _, err = grpcClient.GetBlock(context.Background(), &pb.GetBlockRequest{BlockHash: hash})
+    if status.Code(err) != codes.Unimplemented {
+        t.Errorf("got gRPC code %s, want %s", status.Code(err), codes.Unimplemented)
+    }
+}
+
+func TestReplayGivesBackHTTPStatusErrors(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+
+    recording, err := ioutil.TempFile("", "recording")
+    if err != nil {
+        t.Fatal(err)
+    }
+    recording.Close()
+    defer os.Remove(recording.Name())
+
+    recorder, err := router.NewRecordingRPCClient(router.NewHTTPRPCClient(node.URL), recording.Name())
+    if err != nil {
+        t.Fatal(err)
+    }
+    node.Server.InjectFault(fakegeth.Fault{Method: "eth_blockNumber", HTTPStatus: http.StatusServiceUnavailable})
+    _, err = recorder.Call(context.Background(), router.EthRPCRequest{Jsonrpc: "2.0", Method: "eth_blockNumber", Params: []interface{}{}})
+    recorder.Close()
+    if _, ok := err.(*router.HTTPStatusError); !ok {
+        t.Fatalf("recorded %v, want an HTTP status error", err)
+    }
+
+    replay, err := router.NewReplayRPCClient(recording.Name())
+    if err != nil {
+        t.Fatal(err)
+    }
+    _, err = replay.Call(context.Background(), router.EthRPCRequest{Jsonrpc: "2.0", Method: "eth_blockNumber", Params: []interface{}{}})
+    if statusErr, ok := err.(*router.HTTPStatusError); !ok || statusErr.StatusCode != http.StatusServiceUnavailable {
+        t.Errorf("replayed %#v, want the recorded HTTP status error", err)
+    }
+}
+
+func TestImplausibleTransactionCountsAreABadGateway(t *testing.T) {
+    node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        var req struct {
+            Id json.RawMessage `json:"id"`
+        }
+        json.NewDecoder(r.Body).Decode(&req)
+        w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.Id) + `,"result":"0x100000"}`))
+    }))
+    defer node.Close()
+
+    svc := router.NewEthService(router.NewHTTPRPCClient(node.URL))
+    server := serveHTTP(svc)
+    defer server.Close()
+    grpcClient, stop := serveGRPC(t, svc)
+    defer stop()
+
+    hash := "0x" + strings.Repeat("ab", 32)
+    if code := getJSON(t, server.URL + "/getBlockHashTransactions/" + hash, nil); code != http.StatusBadGateway {
+        t.Errorf("got HTTP status %d, want %d", code, http.StatusBadGateway)
+    }
+
+    _, err := grpcClient.GetTxsForBlockHash(context.Background(), &pb.GetTxsForBlockHashRequest{BlockHash: hash})
+    if status.Code(err) != codes.Unavailable {
+        t.Errorf("got gRPC code %s, want %s", status.Code(err), codes.Unavailable)
+    }
+}
+
+func TestQuorumReadsAcceptOnlyAgreeingUpstreams(t *testing.T) {
+    config := fakegeth.DefaultChainConfig
+    first := newFakeNode(config)
+    defer first.Close()
+    second := newFakeNode(config)
+    defer second.Close()
+    config.Seed = "fork"
+    forked := newFakeNode(config)
+    defer forked.Close()
+
+    pool, err := router.NewPoolRPCClient([]router.RPCClientConfig{{Url: first.URL}, {Url: second.URL}, {Url: forked.URL}})
+    if err != nil {
+        t.Fatal(err)
+    }
+    pool.Start()
+    defer pool.Close()
+
+    server := serveHTTP(router.NewEthService(pool))
+    defer server.Close()
+
+    getBlock := func(quorum string, v interface{}) int {
+        req, err := http.NewRequest("GET", server.URL + "/getBlockByNumber/4", nil)
+        if err != nil {
+            t.Fatal(err)
+        }
+        req.Header.Set(router.QuorumHeader, quorum)
+
+        resp, err := http.DefaultClient.Do(req)
+        if err != nil {
+            t.Fatal(err)
+        }
+        defer resp.Body.Close()
+
+        if v != nil && resp.StatusCode == http.StatusOK {
+            if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+                t.Fatal(err)
+            }
+        }
+        return resp.StatusCode
+    }
+
+    want, _ := first.Chain.BlockByNumber(4)
+    var got router.Block
+    if code := getBlock("2/3", &got); code != http.StatusOK {
+        t.Fatalf("status %d for 2/3", code)
+    }
+    if got.Hash != want.Hash {
+        t.Errorf("got block %s, want %s the two agreeing upstreams hold", got.Hash, want.Hash)
+    }
+    for _, node := range []*fakeNode{first, second, forked} {
+        if calls := node.Server.Calls("eth_getBlockByNumber"); calls != 1 {
+            t.Errorf("upstream got %d lookups, want 1", calls)
+        }
+    }
+
+    if code := getBlock("3/3", nil); code != http.StatusBadGateway {
+        t.Errorf("status %d for 3/3 with a forked upstream, want 502", code)
+    }
+}
+
+func TestReplayAnswersAsTheRecordedNode(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+
+    recording, err := ioutil.TempFile("", "recording")
+    if err != nil {
+        t.Fatal(err)
+    }
+    recording.Close()
+    defer os.Remove(recording.Name())
+
+    recorder, err := router.NewRecordingRPCClient(router.NewHTTPRPCClient(node.URL), recording.Name())
+    if err != nil {
+        t.Fatal(err)
+    }
+    recorded := serveHTTP(router.NewEthService(recorder))
+
+    block, _ := node.Chain.BlockByNumber(3)
+    missing := "0x" + strings.Repeat("ab", 32)
+    paths := []string{
+        "/getBlockByHash/" + block.Hash,
+        "/getBlockHashTransactions/" + block.Hash,
+        "/getTransactionReceipt/" + block.Transactions[1].Hash,
+        "/getBlockByHash/" + missing,
+    }
+
+    get := func(url string) (int, string) {
+        resp, err := http.Get(url)
+        if err != nil {
+            t.Fatal(err)
+        }
+        defer resp.Body.Close()
+
+        body, err := ioutil.ReadAll(resp.Body)
+        if err != nil {
+            t.Fatal(err)
+        }
+        return resp.StatusCode, string(body)
+    }
+
+    statuses := make([]int, len(paths))
+    bodies := make([]string, len(paths))
+    for i, path := range paths {
+        statuses[i], bodies[i] = get(recorded.URL + path)
+    }
+    recorded.Close()
+    recorder.Close()
+    node.Close()
+
+    replay, err := router.NewReplayRPCClient(recording.Name())
+    if err != nil {
+        t.Fatal(err)
+    }
+    replay.Strict = true
+    replayed := serveHTTP(router.NewEthService(replay))
+    defer replayed.Close()
+
+    for i, path := range paths {
+        code, body := get(replayed.URL + path)
+        if code != statuses[i] || body != bodies[i] {
+            t.Errorf("%s replayed %d %s, recorded %d %s", path, code, body, statuses[i], bodies[i])
+        }
+    }
+}
+
+func TestCircuitBreakerReopensWhenTheTrialFails(t *testing.T) {
+    node := newFakeNode(fakegeth.DefaultChainConfig)
+    defer node.Close()
+
+    breaker := router.NewCircuitBreaker(router.CircuitBreakerConfig{
+        FailureThreshold: 1,
+        OpenTimeout: 50 * time.Millisecond,
+        HalfOpenMaxRequests: 1,
+    })
+    server := serveHTTP(router.NewEthService(router.NewHTTPRPCClient(node.URL)), breaker.Middleware())
+    defer server.Close()
+
+    block, _ := node.Chain.BlockByNumber(2)
+    url := server.URL + "/getBlockByHash/" + block.Hash
+
+    node.Server.InjectFault(fakegeth.Fault{Drop: true})
+    if code := getJSON(t, url, nil); code != http.StatusServiceUnavailable {
+        t.Fatalf("status %d while dropping, want 503", code)
+    }
+    if breaker.State() != router.CircuitOpen {
+        t.Fatalf("breaker %s after a failure, want open", breaker.State())
+    }
+
+    time.Sleep(60 * time.Millisecond)
+    before := node.Requests()
+    if code := getJSON(t, url, nil); code != http.StatusServiceUnavailable {
+        t.Errorf("status %d for a failing trial, want 503", code)
+    }
+    if node.Requests() != before + 1 {
+        t.Errorf("node got %d requests in half-open, want the one trial", node.Requests() - before)
+    }
+    if breaker.State() != router.CircuitOpen {
+        t.Fatalf("breaker %s after a failed trial, want open again", breaker.State())
+    }
+
+    node.Server.ClearFaults()
+    before = node.Requests()
+    if code := getJSON(t, url, nil); code != http.StatusServiceUnavailable {
+        t.Errorf("status %d right after reopening, want 503", code)
+    }
+    if node.Requests() != before {
+        t.Error("reopened breaker called the node")
+    }
+
+    time.Sleep(60 * time.Millisecond)
+    if code := getJSON(t, url, nil); code != http.StatusOK {
+        t.Fatalf("status %d for the next trial, want 200", code)
+    }
+    if breaker.State() != router.CircuitClosed {
+        t.Errorf("breaker %s after a successful trial, want closed", breaker.State())
+    }
+}
\ No newline at end of file
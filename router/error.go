@@ -1,11 +1,131 @@
 package router
 
 import (
+    "encoding/json"
     "errors"
+    "strconv"
 )
 var ErrConnectingToGeth = errors.New("Error connecting to geth!")
 var ErrReadingGethResponse = errors.New("Error reading response from Geth!")
 var ErrParsingJSON = errors.New("Error while parsing JSON!")
 var ErrEncodingJSON = errors.New("Error while encoding JSON!")
 var ErrParsingInt = errors.New("Error while parsing Int!")
-var ErrNullResult = errors.New("Error! Geth returned NULL result!")
\ No newline at end of file
+var ErrNullResult = errors.New("Error! Geth returned NULL result!")
+var ErrResponseIdMismatch = errors.New("Error! Geth response id does not match the request!")
+var ErrBatchMismatch = errors.New("Error! Geth batch response does not match the request!")
+var ErrInvalidUpstreamUrl = errors.New("Error! Unsupported upstream url!")
+var ErrUpstreamUnavailable = errors.New("Error! Upstream is unavailable, circuit breaker is open!")
+var ErrUnsupportedSubscription = errors.New("Error! Unsupported subscription type!")
+var ErrNoUpstreamStatus = errors.New("Error! Upstream client does not report its status!")
+var ErrReadingJWTSecret = errors.New("Error reading upstream JWT secret file!")
+var ErrInvalidJWTSecret = errors.New("Error! Upstream JWT secret must be 32 hex encoded bytes!")
+var ErrInvalidQuorum = errors.New("Error! Quorum must be N or N/M with 1 <= N <= M!")
+var ErrQuorumUnavailable = errors.New("Error! Not enough upstreams to reach quorum!")
+var ErrInvalidRetryMethod = errors.New("Error! Retry methods must be method=N with N >= 1!")
+var ErrInconsistentUpstreams = errors.New("Error! Upstreams returned inconsistent results!")
+// ErrImplausibleUpstreamResponse is returned when the node answers with a
+// well formed value no real chain would hold, such as a block of more
+// transactions than fit in it. It is reported as a bad gateway.
+var ErrImplausibleUpstreamResponse = errors.New("Error! Geth returned an implausible response!")
+var ErrInvalidBlockHash = errors.New("Error! Block hash must be 0x followed by 64 hex digits!")
+var ErrInvalidBlockNumber = errors.New("Error! Block number must be a number or one of latest, pending, earliest, safe, finalized!")
+var ErrInvalidTransactionHash = errors.New("Error! Transaction hash must be 0x followed by 64 hex digits!")
+var ErrInvalidAddress = errors.New("Error! Address must be 0x followed by 40 hex digits!")
+var ErrInvalidStorageSlot = errors.New("Error! Storage slot must be a decimal or hex number of at most 32 bytes!")
+var ErrNoAddresses = errors.New("Error! At least one address is required!")
+var ErrTooManyAddresses = errors.New("Error! Too many addresses in one request!")
+var ErrInvalidTopic = errors.New("Error! Topics must be 0x followed by 64 hex digits, at most 4 of them!")
+var ErrInvalidBlockRange = errors.New("Error! Block range must not end before it starts!")
+var ErrUnresolvedBlockTag = errors.New("Error! Node gave no number for the block tag!")
+var ErrInvalidCursor = errors.New("Error! Invalid pagination cursor!")
+var ErrInvalidLimit = errors.New("Error! Limit must be a positive number!")
+var ErrInvalidABI = errors.New("Error! Invalid contract ABI!")
+var ErrInvalidABIName = errors.New("Error! ABI name may only hold letters, digits, dots, dashes and underscores!")
+var ErrUnknownABI = errors.New("Error! No ABI is registered under that name!")
+var ErrFixedABI = errors.New("Error! ABI was loaded from the ABI directory and cannot be replaced!")
+var ErrTooManyABIs = errors.New("Error! Too many ABIs are registered!")
+var ErrMissingABI = errors.New("Error! Either an ABI or the name of a registered ABI is required!")
+var ErrUnknownFunction = errors.New("Error! Function is not in the contract ABI!")
+var ErrAmbiguousFunction = errors.New("Error! Function is overloaded, call it by signature!")
+var ErrWrongArgumentCount = errors.New("Error! Wrong number of arguments for the function!")
+var ErrEmptyCallResult = errors.New("Error! Call returned no data, is there a contract at that address?")
+var ErrDecodingABI = errors.New("Error! Call result does not match the function outputs!")
+var ErrInvalidRequestBody = errors.New("Error! Request body is not valid JSON!")
+var ErrRequestBodyTooLarge = errors.New("Error! Request body is too large!")
+var ErrInvalidBoolParam = errors.New("Error! Boolean parameter must be true or false!")
+var ErrUnrecordedCall = errors.New("Error! Call was not recorded and replay is strict!")
+var ErrConflictingUpstreamAuth = errors.New("Error! Upstream can use either basic auth or a JWT secret, not both!")
+var ErrDebugDisabled = errors.New("Error! Debug endpoints are disabled, no debug tokens are configured!")
+var ErrMissingDebugToken = errors.New("Error! Debug endpoints require a debug token!")
+var ErrInvalidDebugToken = errors.New("Error! Invalid debug token!")
+var ErrTooManyTraces = errors.New("Error! Too many traces running, try again later!")
+var ErrNoDebugTokens = errors.New("Error! Debug token file holds no tokens!")
+
+// RPCError is the "error" object of a JSON-RPC response from the node.
+type RPCError struct {
+    Code int `json:"code"`
+    Message string `json:"message"`
+    Data json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string {
+    return "Geth returned error " + strconv.Itoa(e.Code) + ": " + e.Message
+}
+
+// TransactionRejectedError tells why a raw transaction was refused before
+// it reached the node. Reason is one of the TxReject codes, Details holds
+// the values behind it, such as the expected and the given nonce.
+type TransactionRejectedError struct {
+    Reason string
+    Message string
+    Details map[string]string
+}
+
+func (e *TransactionRejectedError) Error() string {
+    return "Error! Transaction rejected, " + e.Message + "!"
+}
+
+// Reasons for rejecting a raw transaction. The first three mean it could
+// not be decoded, the others that it does not fit the chain.
+const (
+    TxRejectMalformed = "malformed"
+    TxRejectUnsupportedType = "unsupported_type"
+    TxRejectInvalidSignature = "invalid_signature"
+    TxRejectUnprotected = "unprotected"
+    TxRejectWrongChain = "wrong_chain_id"
+    TxRejectNonceTooLow = "nonce_too_low"
+    TxRejectNonceTooHigh = "nonce_too_high"
+    TxRejectIntrinsicGas = "intrinsic_gas_too_low"
+    TxRejectGasLimit = "gas_limit_exceeded"
+    TxRejectTipAboveFeeCap = "tip_above_fee_cap"
+    TxRejectFeeCapTooLow = "fee_cap_below_base_fee"
+    TxRejectFeeTooHigh = "fee_too_high"
+)
+
+// isMalformedTransaction tells whether a rejection is about the encoding
+// of the transaction rather than its fit to the chain.
+func (e *TransactionRejectedError) isMalformed() bool {
+    return e.Reason == TxRejectMalformed || e.Reason == TxRejectUnsupportedType || e.Reason == TxRejectInvalidSignature
+}
+
+// HTTPStatusError is returned when the node, or a proxy in front of it,
+// answers with a non-200 HTTP status.
+type HTTPStatusError struct {
+    StatusCode int
+}
+
+func (e *HTTPStatusError) Error() string {
+    return "Geth responded with HTTP status " + strconv.Itoa(e.StatusCode) + "!"
+}
+
+// JSON-RPC 2.0 and geth specific error codes.
+const (
+    RPCErrParse = -32700
+    RPCErrInvalidRequest = -32600
+    RPCErrMethodNotFound = -32601
+    RPCErrInvalidParams = -32602
+    RPCErrInternal = -32603
+    RPCErrServer = -32000
+    RPCErrLimitExceeded = -32005
+    RPCErrExecutionReverted = 3
+)
\ No newline at end of file
@@ -0,0 +1,316 @@
+package router
+
+import (
+    "context"
+    "encoding/hex"
+    "encoding/json"
+    "fmt"
+    "math/big"
+    "strconv"
+    "strings"
+)
+
+/* ----- JSON-RPC REQUESTS ----- */
+type EthRPCRequest struct {
+    Jsonrpc string `json:"jsonrpc"`
+    Method string `json:"method"`
+    Params []interface{} `json:"params"`
+    Id int32 `json:"id"`
+}
+
+// CallArgs is the transaction object taken by eth_call and eth_estimateGas.
+type CallArgs struct {
+    From string `json:"from,omitempty"`
+    To string `json:"to,omitempty"`
+    Gas string `json:"gas,omitempty"`
+    GasPrice string `json:"gasPrice,omitempty"`
+    MaxFeePerGas string `json:"maxFeePerGas,omitempty"`
+    MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas,omitempty"`
+    Value string `json:"value,omitempty"`
+    Data string `json:"data,omitempty"`
+}
+
+// LogFilter is the filter object taken by eth_getLogs and the "logs"
+// subscription. Each entry of Topics is nil (any), a topic, or a list of
+// alternative topics.
+type LogFilter struct {
+    FromBlock string `json:"fromBlock,omitempty"`
+    ToBlock string `json:"toBlock,omitempty"`
+    BlockHash string `json:"blockHash,omitempty"`
+    Address []string `json:"address,omitempty"`
+    Topics []interface{} `json:"topics,omitempty"`
+}
+
+// BlockHashParam selects a block by hash in state queries, as in EIP-1898.
+type BlockHashParam struct {
+    BlockHash string `json:"blockHash"`
+}
+
+func (ethreq *EthRPCRequest) construct(method string, params ...interface{}) {
+    if params == nil {
+        params = []interface{}{}
+    }
+
+    ethreq.Jsonrpc = "2.0"
+    ethreq.Method = method
+    ethreq.Params = params
+}
+
+func (ethreq *EthRPCRequest) constructGetSyncingRequest() {
+    ethreq.construct("eth_syncing")
+}
+
+func (ethreq *EthRPCRequest) constructChainIdRequest() {
+    ethreq.construct("eth_chainId")
+}
+
+func (ethreq *EthRPCRequest) constructGetBlockNumberRequest() {
+    ethreq.construct("eth_blockNumber")
+}
+
+func (ethreq *EthRPCRequest) constructGetBlockTransactionCountByHashRequest(blockHash string) {
+    ethreq.construct("eth_getBlockTransactionCountByHash", blockHash)
+}
+
+func (ethreq *EthRPCRequest) constructGetTransactionByBlockHashAndIndexRequest(blockHash string, transactionIndex int64) {
+    ethreq.construct("eth_getTransactionByBlockHashAndIndex", blockHash, encodeHexInt(transactionIndex))
+}
+
+func (ethreq *EthRPCRequest) constructGetBlockByHashRequest(blockHash string, fullTransactions bool) {
+    ethreq.construct("eth_getBlockByHash", blockHash, fullTransactions)
+}
+
+func (ethreq *EthRPCRequest) constructGetBlockByNumberRequest(block string, fullTransactions bool) {
+    ethreq.construct("eth_getBlockByNumber", block, fullTransactions)
+}
+
+func (ethreq *EthRPCRequest) constructGetTransactionByHashRequest(txHash string) {
+    ethreq.construct("eth_getTransactionByHash", txHash)
+}
+
+func (ethreq *EthRPCRequest) constructGetTransactionReceiptRequest(txHash string) {
+    ethreq.construct("eth_getTransactionReceipt", txHash)
+}
+
+func (ethreq *EthRPCRequest) constructCallRequest(call CallArgs, block interface{}) {
+    ethreq.construct("eth_call", call, block)
+}
+
+func (ethreq *EthRPCRequest) constructGetLogsRequest(filter LogFilter) {
+    ethreq.construct("eth_getLogs", filter)
+}
+
+func (ethreq *EthRPCRequest) constructGetBalanceRequest(address string, block interface{}) {
+    ethreq.construct("eth_getBalance", address, block)
+}
+
+func (ethreq *EthRPCRequest) constructGetTransactionCountRequest(address string, block interface{}) {
+    ethreq.construct("eth_getTransactionCount", address, block)
+}
+
+func (ethreq *EthRPCRequest) constructGetCodeRequest(address string, block interface{}) {
+    ethreq.construct("eth_getCode", address, block)
+}
+
+func (ethreq *EthRPCRequest) constructGetStorageAtRequest(address string, slot string, block interface{}) {
+    ethreq.construct("eth_getStorageAt", address, slot, block)
+}
+
+func (ethreq *EthRPCRequest) constructFeeHistoryRequest(blockCount int64, newestBlock string, percentiles []float64) {
+    ethreq.construct("eth_feeHistory", encodeHexInt(blockCount), newestBlock, percentiles)
+}
+
+func (ethreq *EthRPCRequest) constructMaxPriorityFeePerGasRequest() {
+    ethreq.construct("eth_maxPriorityFeePerGas")
+}
+
+func (ethreq *EthRPCRequest) constructEstimateGasRequest(call CallArgs) {
+    ethreq.construct("eth_estimateGas", call)
+}
+
+func (ethreq *EthRPCRequest) constructSendRawTransactionRequest(rawTx string) {
+    ethreq.construct("eth_sendRawTransaction", rawTx)
+}
+
+func (ethreq *EthRPCRequest) constructTraceTransactionRequest(txHash string, tracer string) {
+    ethreq.construct("debug_traceTransaction", txHash, traceConfig{tracer})
+}
+
+/* ----- JSON-RPC RESPONSES ----- */
+type rpcResponse struct {
+    Jsonrpc string `json:"jsonrpc"`
+    Id *int32 `json:"id"`
+    Result json.RawMessage `json:"result"`
+    Error *RPCError `json:"error"`
+}
+
+// decodeRPCResult unmarshals the "result" of a JSON-RPC response into
+// result. The node's error object comes back as *RPCError and a missing or
+// null result as ErrNullResult.
+func decodeRPCResult(resp []byte, result interface{}) error {
+    var rpcResp rpcResponse
+    err := json.Unmarshal(resp, &rpcResp)
+    if err != nil {
+        return ErrParsingJSON
+    }
+
+    if rpcResp.Error != nil {
+        return rpcResp.Error
+    }
+
+    if len(rpcResp.Result) == 0 || string(rpcResp.Result) == "null" {
+        return ErrNullResult
+    }
+
+    err = json.Unmarshal(rpcResp.Result, result)
+    if err != nil {
+        return ErrParsingJSON
+    }
+
+    return nil
+}
+
+// callRPC sends rpcReq through client and decodes its result into result.
+func callRPC(ctx context.Context, client RPCClient, rpcReq EthRPCRequest, result interface{}) error {
+    resp, err := client.Call(ctx, rpcReq)
+    if err != nil {
+        return err
+    }
+
+    return decodeRPCResult(resp, result)
+}
+
+// callRPCBatch sends rpcReqs as a batch and decodes the i-th result into
+// results[i]. The first failing element aborts decoding.
+func callRPCBatch(ctx context.Context, client RPCClient, rpcReqs []EthRPCRequest, results []interface{}) error {
+    resps, err := client.CallBatch(ctx, rpcReqs)
+    if err != nil {
+        return err
+    }
+
+    for i, resp := range resps {
+        err = decodeRPCResult(resp, results[i])
+        if err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
+
+/* ----- VALIDATION ----- */
+var blockTags = map[string]bool{
+    "latest": true,
+    "pending": true,
+    "earliest": true,
+    "safe": true,
+    "finalized": true,
+}
+
+func isHexAddress(address string) bool {
+    return isHexData(address, 20)
+}
+
+func isHexHash(hash string) bool {
+    return isHexData(hash, 32)
+}
+
+// isHexData tells whether data is 0x followed by exactly size hex encoded
+// bytes.
+func isHexData(data string, size int) bool {
+    if len(data) != 2 + 2 * size || !strings.HasPrefix(data, "0x") {
+        return false
+    }
+
+    _, err := hex.DecodeString(data[2:])
+    return err == nil
+}
+
+// normalizeBlockNumber turns a decimal or hex block number into the hex
+// quantity the node expects, and lets block tags through.
+func normalizeBlockNumber(block string) (string, error) {
+    if blockTags[block] {
+        return block, nil
+    }
+
+    base := 10
+    digits := block
+    if strings.HasPrefix(block, "0x") {
+        base = 16
+        digits = block[2:]
+    }
+
+    number, err := strconv.ParseUint(digits, base, 63)
+    if err != nil || digits == "" {
+        return "", ErrInvalidBlockNumber
+    }
+
+    return encodeHexInt(int64(number)), nil
+}
+
+// normalizeBlockParam turns the optional block of a state query into its
+// JSON-RPC parameter: a block hash, a normalized block number or a tag,
+// latest when empty.
+func normalizeBlockParam(block string) (interface{}, error) {
+    if block == "" {
+        return "latest", nil
+    }
+
+    if strings.HasPrefix(block, "0x") && len(block) == 66 {
+        if !isHexHash(block) {
+            return nil, ErrInvalidBlockHash
+        }
+        return BlockHashParam{block}, nil
+    }
+
+    return normalizeBlockNumber(block)
+}
+
+// normalizeStorageSlot turns a decimal or hex storage position into the 32
+// byte hex slot the node expects.
+func normalizeStorageSlot(slot string) (string, error) {
+    base := 10
+    digits := slot
+    if strings.HasPrefix(slot, "0x") {
+        base = 16
+        digits = slot[2:]
+    }
+
+    position, ok := new(big.Int).SetString(digits, base)
+    if !ok || position.Sign() < 0 || position.BitLen() > 256 {
+        return "", ErrInvalidStorageSlot
+    }
+
+    return fmt.Sprintf("0x%064x", position), nil
+}
+
+// canonicalJSON re-encodes data with sorted object keys and no whitespace,
+// so equal values compare equal as strings. Invalid JSON is returned as is.
+func canonicalJSON(data []byte) string {
+    var value interface{}
+    if json.Unmarshal(data, &value) != nil {
+        return string(data)
+    }
+
+    canonical, _ := json.Marshal(value)
+    return string(canonical)
+}
+
+func encodeHexInt(n int64) string {
+    return "0x" + strconv.FormatInt(n, 16)
+}
+
+// decodeHexInt reads a JSON-RPC quantity: 0x followed by hex digits, never
+// negative and small enough for an int64.
+func decodeHexInt(hexInt string) (int64, error) {
+    if !strings.HasPrefix(hexInt, "0x") {
+        return 0, ErrParsingInt
+    }
+
+    n, err := strconv.ParseUint(hexInt[2:], 16, 63)
+    if err != nil {
+        return 0, ErrParsingInt
+    }
+
+    return int64(n), nil
+}
\ No newline at end of file
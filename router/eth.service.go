@@ -1,36 +1,47 @@
 package router
 
 import (
+    "context"
     "encoding/json"
-    "log"
-    "bytes"
-    "io/ioutil"
-    "net/http"
-    "sync"
-    "strconv"
+    "math/big"
 )
-var wg sync.WaitGroup
-const gethUrl string = "http://localhost:8545"
 
 type EthService interface {
-    GetSyncStatus() (interface{}, error)
-    GetTransactions(string) (interface{}, error)
+    GetSyncStatus(context.Context) (interface{}, error)
+    GetTransactions(context.Context, string) (interface{}, error)
+    GetUpstreamStatus(context.Context) (interface{}, error)
+    GetBlockByHash(context.Context, string, bool) (interface{}, error)
+    GetBlockByNumber(context.Context, string, bool) (interface{}, error)
+    GetTransactionByHash(context.Context, string) (interface{}, error)
+    GetTransactionReceipt(context.Context, string) (interface{}, error)
+    GetTransactionWithReceipt(context.Context, string) (interface{}, error)
+    GetBalance(context.Context, string, string) (interface{}, error)
+    GetTransactionCount(context.Context, string, string) (interface{}, error)
+    GetCode(context.Context, string, string) (interface{}, error)
+    GetStorageAt(context.Context, string, string, string) (interface{}, error)
+    GetBalanceBatch(context.Context, []string, string) (interface{}, error)
+    GetTransactionCountBatch(context.Context, []string, string) (interface{}, error)
+    GetCodeBatch(context.Context, []string, string) (interface{}, error)
+    GetStorageAtBatch(context.Context, []string, string, string) (interface{}, error)
+    GetLogs(context.Context, GetLogsRequest) (interface{}, error)
+    CallContract(context.Context, CallContractRequest) (interface{}, error)
+    RegisterABI(context.Context, string, json.RawMessage) (interface{}, error)
+    SendRawTransaction(context.Context, string) (interface{}, error)
+    SuggestFees(context.Context, SuggestFeesRequest) (interface{}, error)
+    TraceTransaction(context.Context, TraceTransactionRequest) (interface{}, error)
+    GetTokenBalance(context.Context, GetTokenBalanceRequest) (interface{}, error)
 }
 
-/* ----- INTERFACE IMPLEMENTORS ----- */
-type EthRPCRequest struct {
-    Jsonrpc string `json:"jsonrpc"`
-    Method string `json:"method"`
-    Params []string `json:"params"`
-    Id int32 `json:"id"`
-}
+// MaxAccountsPerRequest bounds the addresses of one batched account state
+// request.
+const MaxAccountsPerRequest int = 100
 
-type BlockTransactionCount struct {
-    Jsonrpc string `json:"jsonrpc"`
-    Result string `json:"result"`
-    Id int32 `json:"id"`
-}
+// maxBlockTransactionCount bounds the transaction count a node may report
+// for a block, larger counts fail with ErrImplausibleUpstreamResponse. Even
+// at a gas limit of a billion a block holds under 50000 plain transfers.
+const maxBlockTransactionCount int64 = 1 << 16
 
+/* ----- INTERFACE IMPLEMENTORS ----- */
 type Transaction struct {
     BlockHash string `json:"blockHash"`
     BlockNumber string `json:"blockNumber"`
@@ -46,12 +57,115 @@ type Transaction struct {
     V string `json:"v"`
     R string `json:"r"`
     S string `json:"s"`
+    Type string `json:"type,omitempty"`
+    ChainId string `json:"chainId,omitempty"`
+    MaxFeePerGas string `json:"maxFeePerGas,omitempty"`
+    MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas,omitempty"`
+    TokenMovements []TokenMovement `json:"tokenMovements,omitempty"`
+}
+
+type Withdrawal struct {
+    Index string `json:"index"`
+    ValidatorIndex string `json:"validatorIndex"`
+    Address string `json:"address"`
+    Amount string `json:"amount"`
+}
+
+// Block is a block header with the hashes of its transactions, and the
+// transactions themselves when asked for. Fields introduced by later forks
+// are empty for blocks before them.
+type Block struct {
+    Number string `json:"number"`
+    Hash string `json:"hash"`
+    ParentHash string `json:"parentHash"`
+    Nonce string `json:"nonce"`
+    Sha3Uncles string `json:"sha3Uncles"`
+    LogsBloom string `json:"logsBloom"`
+    TransactionsRoot string `json:"transactionsRoot"`
+    StateRoot string `json:"stateRoot"`
+    ReceiptsRoot string `json:"receiptsRoot"`
+    Miner string `json:"miner"`
+    Difficulty string `json:"difficulty"`
+    TotalDifficulty string `json:"totalDifficulty,omitempty"`
+    ExtraData string `json:"extraData"`
+    Size string `json:"size"`
+    GasLimit string `json:"gasLimit"`
+    GasUsed string `json:"gasUsed"`
+    Timestamp string `json:"timestamp"`
+    BaseFeePerGas string `json:"baseFeePerGas,omitempty"`
+    MixHash string `json:"mixHash"`
+    WithdrawalsRoot string `json:"withdrawalsRoot,omitempty"`
+    Withdrawals []Withdrawal `json:"withdrawals,omitempty"`
+    BlobGasUsed string `json:"blobGasUsed,omitempty"`
+    ExcessBlobGas string `json:"excessBlobGas,omitempty"`
+    ParentBeaconBlockRoot string `json:"parentBeaconBlockRoot,omitempty"`
+    Uncles []string `json:"uncles"`
+    TransactionHashes []string `json:"transactionHashes"`
+    Transactions []Transaction `json:"transactions,omitempty"`
+}
+
+type Log struct {
+    Address string `json:"address"`
+    Topics []string `json:"topics"`
+    Data string `json:"data"`
+    BlockNumber string `json:"blockNumber"`
+    BlockHash string `json:"blockHash"`
+    TransactionHash string `json:"transactionHash"`
+    TransactionIndex string `json:"transactionIndex"`
+    LogIndex string `json:"logIndex"`
+    Removed bool `json:"removed"`
+}
+
+// Receipt is the outcome of a mined transaction. Status is 0x1 on success
+// and 0x0 on failure; blocks before Byzantium carry Root instead.
+// ContractAddress is only set for contract creations. TokenMovements are
+// decoded from the logs.
+type Receipt struct {
+    TransactionHash string `json:"transactionHash"`
+    TransactionIndex string `json:"transactionIndex"`
+    BlockHash string `json:"blockHash"`
+    BlockNumber string `json:"blockNumber"`
+    From string `json:"from"`
+    To string `json:"to"`
+    CumulativeGasUsed string `json:"cumulativeGasUsed"`
+    GasUsed string `json:"gasUsed"`
+    EffectiveGasPrice string `json:"effectiveGasPrice"`
+    ContractAddress string `json:"contractAddress,omitempty"`
+    Logs []Log `json:"logs"`
+    LogsBloom string `json:"logsBloom"`
+    Status string `json:"status,omitempty"`
+    Root string `json:"root,omitempty"`
+    Type string `json:"type,omitempty"`
+    BlobGasUsed string `json:"blobGasUsed,omitempty"`
+    BlobGasPrice string `json:"blobGasPrice,omitempty"`
+    TokenMovements []TokenMovement `json:"tokenMovements,omitempty"`
+}
+
+// TransactionWithReceipt merges a transaction with its receipt, which is
+// nil while the transaction is pending.
+type TransactionWithReceipt struct {
+    Transaction
+    Receipt *Receipt `json:"receipt"`
+}
+
+// rpcBlock is a block as the node sends it, where "transactions" holds
+// either hashes or transaction objects.
+type rpcBlock struct {
+    Block
+    RawTransactions json.RawMessage `json:"transactions"`
+}
+
+// AccountValue is one piece of account state at a block: a balance, a
+// transaction count, code or the value of a storage slot.
+type AccountValue struct {
+    Address string `json:"address"`
+    Block string `json:"block"`
+    Slot string `json:"slot,omitempty"`
+    Value string `json:"value"`
 }
 
-type TransactionResult struct {
-    Jsonrpc string `json:"jsonrpc"`
-    Result Transaction `json:"result"`
-    Id int32 `json:"id"`
+type AccountValuesResponse struct {
+    Values []AccountValue `json:"values"`
 }
 
 type BlockSyncProgress struct {
@@ -60,154 +174,426 @@ type BlockSyncProgress struct {
     HighestBlock string `json:"highestBlock"`
 }
 
-type GetSyncResult struct {
-    Jsonrpc string `json:"jsonrpc"`
-    Result BlockSyncProgress `json:"result"`
-    Id int32 `json:"id"`
-}
-
 type TransactionResultsResponse struct {
     Transactions []Transaction `json:"transactions"`
 }
 
-type TxChannelResult struct {
-    Tx Transaction
-    Error error
-}
+/* ----- REQUESTS ----- */
 
-func (ethreq *EthRPCRequest) constructGetSyncingRequest() {
-    ethreq.Jsonrpc = "2.0"
-    ethreq.Method = "eth_syncing"
-    ethreq.Params = []string{}
-    ethreq.Id = 0x01
+// GetBlockRequest selects a block by BlockHash, or by BlockNumber when the
+// hash is empty.
+type GetBlockRequest struct {
+    BlockHash string
+    BlockNumber string
+    FullTransactions bool
 }
 
-func (ethreq *EthRPCRequest) constructGetBlockTransactionCountByHashRequest(blockHash string) {
-    ethreq.Jsonrpc = "2.0"
-    ethreq.Method = "eth_getBlockTransactionCountByHash"
-    ethreq.Params = []string{blockHash}
-    ethreq.Id = 0x01    
+type GetTransactionRequest struct {
+    TransactionHash string
+    WithReceipt bool
 }
 
-func (ethreq *EthRPCRequest) constructGetTransactionByBlockHashAndIndexRequest(blockHash string, transactionIndex int64) {
-    var trInx string = "0x" + strconv.FormatInt(transactionIndex, 16)
-    ethreq.Jsonrpc = "2.0"
-    ethreq.Method = "eth_getTransactionByBlockHashAndIndex"
-    ethreq.Params = []string{blockHash, trInx}
-    ethreq.Id = 0x01
-}
+// Kinds of account state taken by GetAccountStateRequest.
+const (
+    AccountBalance string = "balance"
+    AccountTransactionCount string = "transactionCount"
+    AccountCode string = "code"
+    AccountStorage string = "storage"
+)
 
-func callGethRPC (rpcStruct EthRPCRequest) (interface{}, error) {
-    var jsonData []byte
-    jsonData, err := json.Marshal(rpcStruct)
+// GetAccountStateRequest asks for one Kind of state of Addresses at Block, a
+// block number, hash or tag, latest when empty. Slot is only used by storage
+// queries. A Batch request answers with AccountValuesResponse even for a
+// single address.
+type GetAccountStateRequest struct {
+    Kind string
+    Addresses []string
+    Slot string
+    Block string
+    Batch bool
+}
 
-    log.Println("Sending GethRPC request on address \"" + gethUrl + "\" with payload: " + string(jsonData))
+// getAccountState answers req through the matching EthService method.
+func getAccountState(ctx context.Context, svc EthService, req GetAccountStateRequest) (interface{}, error) {
+    if !req.Batch {
+        if len(req.Addresses) != 1 {
+            return nil, ErrNoAddresses
+        }
 
-    resp, err := http.Post(gethUrl, "application/json", bytes.NewBuffer(jsonData))
-    if err != nil {
-        return nil, ErrConnectingToGeth
+        address := req.Addresses[0]
+        switch req.Kind {
+        case AccountBalance:
+            return svc.GetBalance(ctx, address, req.Block)
+        case AccountTransactionCount:
+            return svc.GetTransactionCount(ctx, address, req.Block)
+        case AccountCode:
+            return svc.GetCode(ctx, address, req.Block)
+        }
+        return svc.GetStorageAt(ctx, address, req.Slot, req.Block)
     }
 
-    respBytes, err := ioutil.ReadAll(resp.Body)
-    if err != nil {
-        return nil, ErrReadingGethResponse
+    switch req.Kind {
+    case AccountBalance:
+        return svc.GetBalanceBatch(ctx, req.Addresses, req.Block)
+    case AccountTransactionCount:
+        return svc.GetTransactionCountBatch(ctx, req.Addresses, req.Block)
+    case AccountCode:
+        return svc.GetCodeBatch(ctx, req.Addresses, req.Block)
     }
-    resp.Body.Close()
+    return svc.GetStorageAtBatch(ctx, req.Addresses, req.Slot, req.Block)
+}
 
-    log.Println("Got response from GethRPC with JSON payload: ", string(respBytes))
-    return respBytes, nil
+// RegisterABIRequest carries ABI JSON to register under Name.
+type RegisterABIRequest struct {
+    Name string
+    ABI json.RawMessage
 }
 
-type EthServiceImp struct{}
+type EthServiceImp struct{
+    // LogsChunkSize is the initial block range of one eth_getLogs call.
+    LogsChunkSize int64
+    // ABIs are the contract ABIs CallContract can refer to by name.
+    ABIs *ABIRegistry
+    // TxFeeCap bounds the fees of a transaction SendRawTransaction passes
+    // on, in wei. Nil or zero lets any fee through.
+    TxFeeCap *big.Int
+    // Fees caches the fee suggestions of SuggestFees.
+    Fees *FeeCache
+    // Debug gates TraceTransaction and RegisterABI, which are off until it
+    // has tokens.
+    Debug *DebugAccess
+    // Tokens caches the metadata of tokens whose amounts are rendered.
+    Tokens *TokenCache
+
+    client RPCClient
+}
 
-func (EthServiceImp) GetSyncStatus() (interface{}, error) {
+func NewEthService(client RPCClient) EthServiceImp {
+    return EthServiceImp{DefaultLogsChunkSize, NewABIRegistry(), new(big.Int).Set(DefaultTxFeeCap), NewFeeCache(DefaultFeeCacheTTL), NewDebugAccess(nil, DefaultMaxConcurrentTraces), NewTokenCache(DefaultTokenCacheSize), client}
+}
+
+func (svc EthServiceImp) GetSyncStatus(ctx context.Context) (interface{}, error) {
     rpcReq := EthRPCRequest{}
 
     rpcReq.constructGetSyncingRequest()
 
-    resp, err := callGethRPC(rpcReq)
+    // eth_syncing returns false rather than an object once the node is synced
+    var syncing json.RawMessage
+    err := callRPC(ctx, svc.client, rpcReq, &syncing)
     if err != nil {
         return nil, err
     }
 
-    var getSyncResp GetSyncResult;
-    err = json.Unmarshal(resp.([]byte), &getSyncResp)
+    var syncProgress BlockSyncProgress
+    if string(syncing) == "false" {
+        return syncProgress, nil
+    }
+
+    err = json.Unmarshal(syncing, &syncProgress)
     if err != nil {
         return nil, ErrParsingJSON
     }
 
-    
-    return getSyncResp.Result, nil
+    return syncProgress, nil
 }
 
-func (EthServiceImp) GetTransactions(blockHash string) (interface{}, error) {
+func (svc EthServiceImp) GetTransactions(ctx context.Context, blockHash string) (interface{}, error) {
     rpcReq := EthRPCRequest{}
 
     // Getting transaction count
     rpcReq.constructGetBlockTransactionCountByHashRequest(blockHash)
-    transactionCountResp, err := callGethRPC(rpcReq)
+
+    var txCountHex string
+    err := callRPC(ctx, svc.client, rpcReq, &txCountHex)
     if err != nil {
         return nil, err
     }
 
-    var txCountStruct BlockTransactionCount;
-    err = json.Unmarshal(transactionCountResp.([]byte), &txCountStruct)
+    txCount, err := decodeHexInt(txCountHex)
+    if err != nil {
+        return nil, err
+    }
+    if txCount > maxBlockTransactionCount {
+        return nil, ErrImplausibleUpstreamResponse
+    }
+
+    // Fetching all transactions in as few batches as the client allows
+    txRequests := make([]EthRPCRequest, txCount)
+    txs := make([]Transaction, txCount)
+    txResults := make([]interface{}, txCount)
+    for i := 0; i < int(txCount); i++ {
+        txRequests[i].constructGetTransactionByBlockHashAndIndexRequest(blockHash, int64(i))
+        txResults[i] = &txs[i]
+    }
+
+    err = callRPCBatch(ctx, svc.client, txRequests, txResults)
+    if err != nil {
+        return nil, err
+    }
+    svc.withTokenMovements(ctx, txs, nil)
+
+    txResponse := TransactionResultsResponse{txs}
+
+    return txResponse, nil
+}
+
+func (svc EthServiceImp) GetBlockByHash(ctx context.Context, blockHash string, fullTransactions bool) (interface{}, error) {
+    if !isHexHash(blockHash) {
+        return nil, ErrInvalidBlockHash
+    }
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructGetBlockByHashRequest(blockHash, fullTransactions)
+
+    return svc.getBlock(ctx, rpcReq, fullTransactions)
+}
+
+// GetBlockByNumber takes a decimal or hex block number, or one of the
+// latest, pending, earliest, safe and finalized tags.
+func (svc EthServiceImp) GetBlockByNumber(ctx context.Context, blockNumber string, fullTransactions bool) (interface{}, error) {
+    block, err := normalizeBlockNumber(blockNumber)
+    if err != nil {
+        return nil, err
+    }
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructGetBlockByNumberRequest(block, fullTransactions)
+
+    return svc.getBlock(ctx, rpcReq, fullTransactions)
+}
+
+func (svc EthServiceImp) getBlock(ctx context.Context, rpcReq EthRPCRequest, fullTransactions bool) (interface{}, error) {
+    var result rpcBlock
+    err := callRPC(ctx, svc.client, rpcReq, &result)
+    if err != nil {
+        return nil, err
+    }
+
+    block := result.Block
+    block.TransactionHashes = []string{}
+    if !fullTransactions {
+        err = json.Unmarshal(result.RawTransactions, &block.TransactionHashes)
+        if err != nil {
+            return nil, ErrParsingJSON
+        }
+        return block, nil
+    }
+
+    err = json.Unmarshal(result.RawTransactions, &block.Transactions)
     if err != nil {
         return nil, ErrParsingJSON
     }
 
-    if txCountStruct.Result == "" {
-        return nil, ErrNullResult
+    for _, tx := range block.Transactions {
+        block.TransactionHashes = append(block.TransactionHashes, tx.Hash)
+    }
+    svc.withTokenMovements(ctx, block.Transactions, nil)
+
+    return block, nil
+}
+
+func (svc EthServiceImp) GetTransactionByHash(ctx context.Context, txHash string) (interface{}, error) {
+    if !isHexHash(txHash) {
+        return nil, ErrInvalidTransactionHash
+    }
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructGetTransactionByHashRequest(txHash)
+
+    txs := make([]Transaction, 1)
+    err := callRPC(ctx, svc.client, rpcReq, &txs[0])
+    if err != nil {
+        return nil, err
+    }
+    svc.withTokenMovements(ctx, txs, nil)
+
+    return txs[0], nil
+}
+
+func (svc EthServiceImp) GetTransactionReceipt(ctx context.Context, txHash string) (interface{}, error) {
+    if !isHexHash(txHash) {
+        return nil, ErrInvalidTransactionHash
     }
-    
-    var txCount int64;
-    txCount, err = strconv.ParseInt(txCountStruct.Result, 0, 16)
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructGetTransactionReceiptRequest(txHash)
+
+    var receipt Receipt
+    err := callRPC(ctx, svc.client, rpcReq, &receipt)
     if err != nil {
-        return nil, ErrParsingInt
+        return nil, err
     }
+    svc.withTokenMovements(ctx, nil, []*Receipt{&receipt})
 
-    txChannel := make(chan TxChannelResult, txCount)
-    for i := 0; i < int(txCount); i++ {
-        inji := i
-        wg.Add(1)
-        go func() {
-            defer wg.Done()
-            rpcReq := EthRPCRequest{}
-
-            rpcReq.constructGetTransactionByBlockHashAndIndexRequest(blockHash, int64(inji))
-            txBlockCountResp, err := callGethRPC(rpcReq)
-            if err != nil {
-                txChannel <- TxChannelResult{Transaction{}, err}
-            }
-
-            var txBlockCountRespStruct TransactionResult;
-            err = json.Unmarshal(txBlockCountResp.([]byte), &txBlockCountRespStruct)
-            if err != nil {
-                txChannel <- TxChannelResult{Transaction{}, err}
-                return
-            }
-
-            if txBlockCountRespStruct.Result == (Transaction{}) {
-                txChannel <- TxChannelResult{Transaction{}, ErrNullResult}
-                return
-            }
-
-            txChannel <- TxChannelResult{txBlockCountRespStruct.Result, nil}
-        }()
-    }
-
-    wg.Wait()
-    close(txChannel)
-
-    var txs []Transaction = []Transaction{}
-    for txResult := range txChannel {
-        if txResult.Error != nil {
-            return nil, txResult.Error
+    return receipt, nil
+}
+
+// GetTransactionWithReceipt fetches the transaction and its receipt in one
+// batch. A pending transaction comes back without a receipt.
+func (svc EthServiceImp) GetTransactionWithReceipt(ctx context.Context, txHash string) (interface{}, error) {
+    if !isHexHash(txHash) {
+        return nil, ErrInvalidTransactionHash
+    }
+
+    rpcReqs := make([]EthRPCRequest, 2)
+    rpcReqs[0].constructGetTransactionByHashRequest(txHash)
+    rpcReqs[1].constructGetTransactionReceiptRequest(txHash)
+
+    resps, err := svc.client.CallBatch(ctx, rpcReqs)
+    if err != nil {
+        return nil, err
+    }
+
+    var result TransactionWithReceipt
+    err = decodeRPCResult(resps[0], &result.Transaction)
+    if err != nil {
+        return nil, err
+    }
+
+    var receipt Receipt
+    err = decodeRPCResult(resps[1], &receipt)
+    if err == nil {
+        result.Receipt = &receipt
+    } else if err != ErrNullResult {
+        return nil, err
+    }
+
+    txs := []Transaction{result.Transaction}
+    receipts := []*Receipt{}
+    if result.Receipt != nil {
+        receipts = append(receipts, result.Receipt)
+    }
+    svc.withTokenMovements(ctx, txs, receipts)
+    result.Transaction = txs[0]
+
+    return result, nil
+}
+
+/* ----- ACCOUNT STATE ----- */
+
+// accountStateBuilder fills rpcReq with the state query for address at the
+// block parameter block.
+type accountStateBuilder func(rpcReq *EthRPCRequest, address string, block interface{})
+
+func (svc EthServiceImp) GetBalance(ctx context.Context, address string, block string) (interface{}, error) {
+    return svc.getAccountValue(ctx, address, block, "", (*EthRPCRequest).constructGetBalanceRequest)
+}
+
+func (svc EthServiceImp) GetTransactionCount(ctx context.Context, address string, block string) (interface{}, error) {
+    return svc.getAccountValue(ctx, address, block, "", (*EthRPCRequest).constructGetTransactionCountRequest)
+}
+
+func (svc EthServiceImp) GetCode(ctx context.Context, address string, block string) (interface{}, error) {
+    return svc.getAccountValue(ctx, address, block, "", (*EthRPCRequest).constructGetCodeRequest)
+}
+
+// GetStorageAt takes the slot as a decimal or hex position.
+func (svc EthServiceImp) GetStorageAt(ctx context.Context, address string, slot string, block string) (interface{}, error) {
+    slot, err := normalizeStorageSlot(slot)
+    if err != nil {
+        return nil, err
+    }
+
+    return svc.getAccountValue(ctx, address, block, slot, storageAtBuilder(slot))
+}
+
+func (svc EthServiceImp) GetBalanceBatch(ctx context.Context, addresses []string, block string) (interface{}, error) {
+    return svc.getAccountValueBatch(ctx, addresses, block, "", (*EthRPCRequest).constructGetBalanceRequest)
+}
+
+func (svc EthServiceImp) GetTransactionCountBatch(ctx context.Context, addresses []string, block string) (interface{}, error) {
+    return svc.getAccountValueBatch(ctx, addresses, block, "", (*EthRPCRequest).constructGetTransactionCountRequest)
+}
+
+func (svc EthServiceImp) GetCodeBatch(ctx context.Context, addresses []string, block string) (interface{}, error) {
+    return svc.getAccountValueBatch(ctx, addresses, block, "", (*EthRPCRequest).constructGetCodeRequest)
+}
+
+func (svc EthServiceImp) GetStorageAtBatch(ctx context.Context, addresses []string, slot string, block string) (interface{}, error) {
+    slot, err := normalizeStorageSlot(slot)
+    if err != nil {
+        return nil, err
+    }
+
+    return svc.getAccountValueBatch(ctx, addresses, block, slot, storageAtBuilder(slot))
+}
+
+func storageAtBuilder(slot string) accountStateBuilder {
+    return func(rpcReq *EthRPCRequest, address string, block interface{}) {
+        rpcReq.constructGetStorageAtRequest(address, slot, block)
+    }
+}
+
+func (svc EthServiceImp) getAccountValue(ctx context.Context, address string, block string, slot string, build accountStateBuilder) (interface{}, error) {
+    values, err := svc.getAccountValues(ctx, []string{address}, block, slot, build)
+    if err != nil {
+        return nil, err
+    }
+
+    return values[0], nil
+}
+
+func (svc EthServiceImp) getAccountValueBatch(ctx context.Context, addresses []string, block string, slot string, build accountStateBuilder) (interface{}, error) {
+    if len(addresses) == 0 {
+        return nil, ErrNoAddresses
+    }
+    if len(addresses) > MaxAccountsPerRequest {
+        return nil, ErrTooManyAddresses
+    }
+
+    values, err := svc.getAccountValues(ctx, addresses, block, slot, build)
+    if err != nil {
+        return nil, err
+    }
+
+    return AccountValuesResponse{values}, nil
+}
+
+// getAccountValues queries the state of every address at block, in one batch
+// when there is more than one.
+func (svc EthServiceImp) getAccountValues(ctx context.Context, addresses []string, block string, slot string, build accountStateBuilder) ([]AccountValue, error) {
+    for _, address := range addresses {
+        if !isHexAddress(address) {
+            return nil, ErrInvalidAddress
         }
-        txs = append(txs, txResult.Tx)
     }
 
-    txResponse := TransactionResultsResponse{txs}
+    blockParam, err := normalizeBlockParam(block)
+    if err != nil {
+        return nil, err
+    }
 
-    return txResponse, nil
+    blockLabel, ok := blockParam.(string)
+    if !ok {
+        blockLabel = block
+    }
+
+    rpcReqs := make([]EthRPCRequest, len(addresses))
+    values := make([]AccountValue, len(addresses))
+    results := make([]interface{}, len(addresses))
+    for i, address := range addresses {
+        build(&rpcReqs[i], address, blockParam)
+        values[i] = AccountValue{Address: address, Block: blockLabel, Slot: slot}
+        results[i] = &values[i].Value
+    }
+
+    if len(rpcReqs) == 1 {
+        err = callRPC(ctx, svc.client, rpcReqs[0], results[0])
+    } else {
+        err = callRPCBatch(ctx, svc.client, rpcReqs, results)
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    return values, nil
 }
+
+func (svc EthServiceImp) GetUpstreamStatus(_ context.Context) (interface{}, error) {
+    reporter, ok := findStatusReporter(svc.client)
+    if !ok {
+        return nil, ErrNoUpstreamStatus
+    }
+
+    return reporter.UpstreamStatus(), nil
+}
\ No newline at end of file
@@ -0,0 +1,268 @@
+package router
+
+import (
+    "context"
+    "math/big"
+    "sort"
+    "sync"
+    "time"
+)
+
+// DefaultFeeCacheTTL is how long a fee suggestion is served from cache,
+// well under a block time.
+const DefaultFeeCacheTTL time.Duration = 4 * time.Second
+
+// feeHistoryBlocks is the number of recent blocks fees are suggested from.
+const feeHistoryBlocks int64 = 20
+
+// feeTier suggests fees paying the priority fee at percentile of recent
+// blocks, with room for the base fee to rise to baseFeeMultiplier per mille
+// of the next block's. The base fee rises by at most 12.5% a block.
+type feeTier struct {
+    percentile float64
+    baseFeeMultiplier int64
+}
+
+var (
+    slowFeeTier = feeTier{10, 1125}
+    standardFeeTier = feeTier{50, 1250}
+    fastFeeTier = feeTier{90, 2000}
+)
+
+var feeHistoryPercentiles = []float64{slowFeeTier.percentile, standardFeeTier.percentile, fastFeeTier.percentile}
+
+// SuggestFeesRequest optionally carries a transaction to estimate the gas
+// of next to the fees.
+type SuggestFeesRequest struct {
+    Transaction *CallArgs `json:"transaction,omitempty"`
+}
+
+type FeeTier struct {
+    MaxFeePerGas string `json:"maxFeePerGas"`
+    MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas"`
+}
+
+// FeeSuggestion holds fees for the block after NewestBlock, whose base fee
+// is BaseFeePerGas. GasEstimate is only set when a transaction was given.
+type FeeSuggestion struct {
+    BaseFeePerGas string `json:"baseFeePerGas"`
+    NewestBlock string `json:"newestBlock"`
+    Slow FeeTier `json:"slow"`
+    Standard FeeTier `json:"standard"`
+    Fast FeeTier `json:"fast"`
+    GasEstimate string `json:"gasEstimate,omitempty"`
+}
+
+type feeHistory struct {
+    OldestBlock string `json:"oldestBlock"`
+    BaseFeePerGas []string `json:"baseFeePerGas"`
+    GasUsedRatio []float64 `json:"gasUsedRatio"`
+    Reward [][]string `json:"reward"`
+}
+
+// FeeCache keeps the last fee suggestion for TTL, so a burst of callers
+// costs the node one round trip. Callers arriving during a refresh wait
+// for it rather than starting their own, until their own context ends.
+type FeeCache struct {
+    TTL time.Duration
+
+    mu sync.Mutex
+    fees FeeSuggestion
+    fetched time.Time
+    refreshing *feeRefresh
+}
+
+// feeRefresh is a fetch in flight. done is closed once fees and err are
+// set.
+type feeRefresh struct {
+    ctx context.Context
+    done chan struct{}
+    fees FeeSuggestion
+    err error
+}
+
+func NewFeeCache(ttl time.Duration) *FeeCache {
+    return &FeeCache{TTL: ttl}
+}
+
+// get returns the cached suggestion while fresh, or refreshes it with
+// fetch. Callers share the refresh and its failure, which is not cached.
+func (cache *FeeCache) get(ctx context.Context, fetch func(context.Context) (FeeSuggestion, error)) (FeeSuggestion, error) {
+    for {
+        cache.mu.Lock()
+        if !cache.fetched.IsZero() && time.Since(cache.fetched) < cache.TTL {
+            fees := cache.fees
+            cache.mu.Unlock()
+            return fees, nil
+        }
+
+        refresh := cache.refreshing
+        if refresh == nil {
+            refresh = &feeRefresh{ctx: ctx, done: make(chan struct{})}
+            cache.refreshing = refresh
+            go cache.refresh(refresh, fetch)
+        }
+        cache.mu.Unlock()
+
+        select {
+        case <-refresh.done:
+        case <-ctx.Done():
+            return FeeSuggestion{}, ctx.Err()
+        }
+
+        // A refresh cut short by the context of the caller that started it
+        // says nothing about the node, so callers still waiting start
+        // another.
+        if refresh.err != nil && refresh.ctx.Err() != nil && ctx.Err() == nil {
+            continue
+        }
+        return refresh.fees, refresh.err
+    }
+}
+
+func (cache *FeeCache) refresh(refresh *feeRefresh, fetch func(context.Context) (FeeSuggestion, error)) {
+    refresh.fees, refresh.err = fetch(refresh.ctx)
+
+    cache.mu.Lock()
+    if refresh.err == nil {
+        cache.fees = refresh.fees
+        cache.fetched = time.Now()
+    }
+    cache.refreshing = nil
+    cache.mu.Unlock()
+
+    close(refresh.done)
+}
+
+// SuggestFees suggests slow, standard and fast fees from the priority fees
+// paid in recent blocks, and estimates the gas of req.Transaction when
+// given.
+func (svc EthServiceImp) SuggestFees(ctx context.Context, req SuggestFeesRequest) (interface{}, error) {
+    call := req.Transaction
+    if call != nil && (call.From != "" && !isHexAddress(call.From) || call.To != "" && !isHexAddress(call.To)) {
+        return nil, ErrInvalidAddress
+    }
+
+    fees, err := svc.Fees.get(ctx, svc.fetchFees)
+    if err != nil {
+        return nil, err
+    }
+
+    if call != nil {
+        rpcReq := EthRPCRequest{}
+        rpcReq.constructEstimateGasRequest(*call)
+
+        err = callRPC(ctx, svc.client, rpcReq, &fees.GasEstimate)
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    return fees, nil
+}
+
+// fetchFees asks for the fee history of recent blocks and the node's own
+// priority fee suggestion in one batch. The tiers take the median over the
+// blocks of their percentile, ignoring empty blocks. The node's suggestion
+// is the least the standard tier pays, and the priority fee alone when no
+// recent block had transactions.
+func (svc EthServiceImp) fetchFees(ctx context.Context) (FeeSuggestion, error) {
+    rpcReqs := make([]EthRPCRequest, 2)
+    rpcReqs[0].constructFeeHistoryRequest(feeHistoryBlocks, "latest", feeHistoryPercentiles)
+    rpcReqs[1].constructMaxPriorityFeePerGasRequest()
+
+    var history feeHistory
+    var nodeTipHex string
+    err := callRPCBatch(ctx, svc.client, rpcReqs, []interface{}{&history, &nodeTipHex})
+    if err != nil {
+        return FeeSuggestion{}, err
+    }
+
+    if len(history.BaseFeePerGas) == 0 || len(history.GasUsedRatio) > len(history.Reward) {
+        return FeeSuggestion{}, ErrParsingJSON
+    }
+
+    nodeTip, ok := decodeHexBig(nodeTipHex)
+    if !ok {
+        return FeeSuggestion{}, ErrParsingInt
+    }
+
+    // The last base fee is the one of the next block.
+    baseFeeHex := history.BaseFeePerGas[len(history.BaseFeePerGas) - 1]
+    baseFee, ok := decodeHexBig(baseFeeHex)
+    if !ok {
+        return FeeSuggestion{}, ErrParsingInt
+    }
+
+    oldest, err := decodeHexInt(history.OldestBlock)
+    if err != nil {
+        return FeeSuggestion{}, err
+    }
+
+    tips := make([]*big.Int, len(feeHistoryPercentiles))
+    for i := range feeHistoryPercentiles {
+        tips[i], err = medianReward(history, i)
+        if err != nil {
+            return FeeSuggestion{}, err
+        }
+        if tips[i] == nil {
+            tips[i] = nodeTip
+        }
+    }
+
+    slowTip, standardTip, fastTip := tips[0], tips[1], tips[2]
+    if standardTip.Cmp(nodeTip) < 0 {
+        standardTip = nodeTip
+    }
+    if slowTip.Cmp(standardTip) > 0 {
+        slowTip = standardTip
+    }
+    if fastTip.Cmp(standardTip) < 0 {
+        fastTip = standardTip
+    }
+
+    return FeeSuggestion{
+        BaseFeePerGas: baseFeeHex,
+        NewestBlock: encodeHexInt(oldest + int64(len(history.GasUsedRatio)) - 1),
+        Slow: slowFeeTier.fees(baseFee, slowTip),
+        Standard: standardFeeTier.fees(baseFee, standardTip),
+        Fast: fastFeeTier.fees(baseFee, fastTip),
+    }, nil
+}
+
+func (tier feeTier) fees(baseFee *big.Int, tip *big.Int) FeeTier {
+    maxFee := new(big.Int).Mul(baseFee, big.NewInt(tier.baseFeeMultiplier))
+    maxFee.Div(maxFee, big.NewInt(1000))
+    maxFee.Add(maxFee, tip)
+
+    return FeeTier{encodeHexBig(maxFee), encodeHexBig(tip)}
+}
+
+// medianReward is the median over the non-empty blocks of history of the
+// reward at the percentile with index i, nil without such blocks.
+func medianReward(history feeHistory, i int) (*big.Int, error) {
+    rewards := []*big.Int{}
+    for block, ratio := range history.GasUsedRatio {
+        if ratio == 0 {
+            continue
+        }
+        if i >= len(history.Reward[block]) {
+            return nil, ErrParsingJSON
+        }
+
+        reward, ok := decodeHexBig(history.Reward[block][i])
+        if !ok {
+            return nil, ErrParsingInt
+        }
+        rewards = append(rewards, reward)
+    }
+
+    if len(rewards) == 0 {
+        return nil, nil
+    }
+
+    sort.Slice(rewards, func(a, b int) bool {
+        return rewards[a].Cmp(rewards[b]) < 0
+    })
+    return rewards[len(rewards) / 2], nil
+}
\ No newline at end of file
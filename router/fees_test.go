@@ -0,0 +1,108 @@
+package router
+
+import (
+    "context"
+    "errors"
+    "sync"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+// blockingFetch returns a fetch that counts its calls and answers with
+// fees and err once release is closed, or fails when its context ends.
+func blockingFetch(calls *int32, release chan struct{}, fees FeeSuggestion, err error) func(context.Context) (FeeSuggestion, error) {
+    return func(ctx context.Context) (FeeSuggestion, error) {
+        atomic.AddInt32(calls, 1)
+        select {
+        case <-release:
+            return fees, err
+        case <-ctx.Done():
+            return FeeSuggestion{}, ctx.Err()
+        }
+    }
+}
+
+func TestFeeCacheSharesOneRefresh(t *testing.T) {
+    cache := NewFeeCache(time.Minute)
+    var calls int32
+    release := make(chan struct{})
+    errNode := errors.New("node down")
+    fetch := blockingFetch(&calls, release, FeeSuggestion{}, errNode)
+
+    var wg sync.WaitGroup
+    errs := make([]error, 10)
+    for i := range errs {
+        wg.Add(1)
+        go func(i int) {
+            defer wg.Done()
+            _, errs[i] = cache.get(context.Background(), fetch)
+        }(i)
+    }
+    time.Sleep(20 * time.Millisecond)
+    close(release)
+    wg.Wait()
+
+    if calls != 1 {
+        t.Errorf("got %d fetches, want 1", calls)
+    }
+    for i, err := range errs {
+        if err != errNode {
+            t.Errorf("caller %d got %v, want the shared failure", i, err)
+        }
+    }
+
+    fetch = blockingFetch(&calls, release, FeeSuggestion{BaseFeePerGas: "0x7"}, nil)
+    for i := 0; i < 2; i++ {
+        if fees, err := cache.get(context.Background(), fetch); err != nil || fees.BaseFeePerGas != "0x7" {
+            t.Errorf("got %+v, %v", fees, err)
+        }
+    }
+    if calls != 2 {
+        t.Errorf("got %d fetches, want the failure not cached and the success cached", calls)
+    }
+}
+
+func TestFeeCacheWaitersHonourTheirContext(t *testing.T) {
+    cache := NewFeeCache(time.Minute)
+    var calls int32
+    release := make(chan struct{})
+    fetch := blockingFetch(&calls, release, FeeSuggestion{BaseFeePerGas: "0x7"}, nil)
+
+    leaderCtx, cancelLeader := context.WithCancel(context.Background())
+    leader := make(chan error)
+    go func() {
+        _, err := cache.get(leaderCtx, fetch)
+        leader <- err
+    }()
+    time.Sleep(20 * time.Millisecond)
+
+    ctx, cancel := context.WithTimeout(context.Background(), 20 * time.Millisecond)
+    defer cancel()
+    if _, err := cache.get(ctx, fetch); err != context.DeadlineExceeded {
+        t.Errorf("waiter got %v, want its own deadline", err)
+    }
+
+    waiter := make(chan error)
+    go func() {
+        fees, err := cache.get(context.Background(), fetch)
+        if err == nil && fees.BaseFeePerGas != "0x7" {
+            err = errors.New("got fees " + fees.BaseFeePerGas)
+        }
+        waiter <- err
+    }()
+    time.Sleep(20 * time.Millisecond)
+
+    cancelLeader()
+    if err := <-leader; err != context.Canceled {
+        t.Errorf("leader got %v, want context.Canceled", err)
+    }
+
+    close(release)
+    if err := <-waiter; err != nil {
+        t.Errorf("waiter got %v after the leader gave up, want fees", err)
+    }
+    if calls != 2 {
+        t.Errorf("got %d fetches, want the waiter to refetch once", calls)
+    }
+}
\ No newline at end of file
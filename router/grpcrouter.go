@@ -2,10 +2,14 @@ package router
 
 import (
     "context"
+    "encoding/json"
 
     gt "github.com/go-kit/kit/transport/grpc"
     "github.com/herrjemand/gethGoKitRPCMicroService/proto"
     "github.com/go-kit/kit/endpoint"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/metadata"
+    "google.golang.org/grpc/status"
 )
 
 type GetSyncResponse struct{
@@ -25,10 +29,10 @@ type GetBlockHashTxsRequest struct{
 }
 
 func constructGetSyncEndpointGPRC(svc EthService) endpoint.Endpoint {
-    return func(_ context.Context, _ interface{}) (interface{}, error) {
-        result, err := svc.GetSyncStatus()
+    return func(ctx context.Context, _ interface{}) (interface{}, error) {
+        result, err := svc.GetSyncStatus(ctx)
         if err != nil {
-            return GetSyncResponse{"failed", err.Error(), BlockSyncProgress{}}, nil
+            return nil, err
         }
 
         return GetSyncResponse{"ok", "", result.(BlockSyncProgress)}, nil
@@ -55,10 +59,10 @@ func encodeGetSyncResponseGPRC(_ context.Context, result interface{}) (interface
 }
 
 func constructGetBlockHashTxsEndpointGRPC(svc EthService) endpoint.Endpoint {
-    return func(_ context.Context, request interface{}) (interface{}, error) {
-        result, err := svc.GetTransactions(request.(string))
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetTransactions(ctx, request.(string))
         if err != nil {
-            return GetBlockHashTxsResponse{"failed", err.Error(), []Transaction{}}, nil
+            return nil, err
         }
 
         return GetBlockHashTxsResponse{"ok", "", result.(TransactionResultsResponse).Transactions}, nil
@@ -75,23 +79,7 @@ func encodeGetBlockHashTxsResponseGPRC(_ context.Context, result interface{}) (i
     protoTxs := []*proto.Transaction{}
 
     for _, transaction := range res.Txs {
-        protoTx := &proto.Transaction{
-                BlockHash:        transaction.BlockHash,
-                BlockNumber:      transaction.BlockNumber,
-                From:             transaction.From,
-                Gas:              transaction.Gas,
-                GasPrice:         transaction.GasPrice,
-                Hash:             transaction.Hash,
-                Input:            transaction.Input,
-                Nonce:            transaction.Nonce,
-                To:               transaction.To,
-                TransactionIndex: transaction.TransactionIndex,
-                Value:            transaction.Value,
-                V:                transaction.V,
-                R:                transaction.R,
-                S:                transaction.S,
-        }
-        protoTxs = append(protoTxs, protoTx)
+        protoTxs = append(protoTxs, encodeTransactionGRPC(transaction))
     }
     return &proto.GetTxsForBlockHashResponse{
         Status:       res.Status,
@@ -100,39 +88,919 @@ func encodeGetBlockHashTxsResponseGPRC(_ context.Context, result interface{}) (i
     }, nil
 }
 
+type GetBlockResponse struct{
+    Status string
+    ErrorMessage string
+    Block Block
+}
+
+func constructGetBlockEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        req := request.(GetBlockRequest)
+
+        var result interface{}
+        var err error
+        if req.BlockHash != "" {
+            result, err = svc.GetBlockByHash(ctx, req.BlockHash, req.FullTransactions)
+        } else {
+            result, err = svc.GetBlockByNumber(ctx, req.BlockNumber, req.FullTransactions)
+        }
+        if err != nil {
+            return nil, err
+        }
+
+        return GetBlockResponse{"ok", "", result.(Block)}, nil
+    }
+}
+
+func decodeGetBlockRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.GetBlockRequest)
+
+    blockNumber := req.BlockNumber
+    if req.BlockHash == "" && blockNumber == "" {
+        blockNumber = "latest"
+    }
+
+    return GetBlockRequest{
+        BlockHash: req.BlockHash,
+        BlockNumber: blockNumber,
+        FullTransactions: req.FullTransactions,
+    }, nil
+}
+
+func encodeGetBlockResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(GetBlockResponse)
+    block := res.Block
+
+    protoWithdrawals := []*proto.Withdrawal{}
+    for _, withdrawal := range block.Withdrawals {
+        protoWithdrawals = append(protoWithdrawals, &proto.Withdrawal{
+            Index:          withdrawal.Index,
+            ValidatorIndex: withdrawal.ValidatorIndex,
+            Address:        withdrawal.Address,
+            Amount:         withdrawal.Amount,
+        })
+    }
+
+    protoTxs := []*proto.Transaction{}
+    for _, transaction := range block.Transactions {
+        protoTxs = append(protoTxs, encodeTransactionGRPC(transaction))
+    }
+
+    return &proto.GetBlockResponse{
+        Status:       res.Status,
+        ErrorMessage: res.ErrorMessage,
+        Block: &proto.Block{
+            Number:                block.Number,
+            Hash:                  block.Hash,
+            ParentHash:            block.ParentHash,
+            Nonce:                 block.Nonce,
+            Sha3Uncles:            block.Sha3Uncles,
+            LogsBloom:             block.LogsBloom,
+            TransactionsRoot:      block.TransactionsRoot,
+            StateRoot:             block.StateRoot,
+            ReceiptsRoot:          block.ReceiptsRoot,
+            Miner:                 block.Miner,
+            Difficulty:            block.Difficulty,
+            TotalDifficulty:       block.TotalDifficulty,
+            ExtraData:             block.ExtraData,
+            Size:                  block.Size,
+            GasLimit:              block.GasLimit,
+            GasUsed:               block.GasUsed,
+            Timestamp:             block.Timestamp,
+            BaseFeePerGas:         block.BaseFeePerGas,
+            MixHash:               block.MixHash,
+            WithdrawalsRoot:       block.WithdrawalsRoot,
+            Withdrawals:           protoWithdrawals,
+            BlobGasUsed:           block.BlobGasUsed,
+            ExcessBlobGas:         block.ExcessBlobGas,
+            ParentBeaconBlockRoot: block.ParentBeaconBlockRoot,
+            Uncles:                block.Uncles,
+            TransactionHashes:     block.TransactionHashes,
+            Transactions:          protoTxs,
+        },
+    }, nil
+}
+
+type GetTransactionResponse struct{
+    Status string
+    ErrorMessage string
+    Transaction Transaction
+    Receipt *Receipt
+}
+
+type GetTransactionReceiptResponse struct{
+    Status string
+    ErrorMessage string
+    Receipt Receipt
+}
+
+func constructGetTransactionEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        req := request.(GetTransactionRequest)
+
+        if !req.WithReceipt {
+            result, err := svc.GetTransactionByHash(ctx, req.TransactionHash)
+            if err != nil {
+                return nil, err
+            }
+
+            return GetTransactionResponse{"ok", "", result.(Transaction), nil}, nil
+        }
+
+        result, err := svc.GetTransactionWithReceipt(ctx, req.TransactionHash)
+        if err != nil {
+            return nil, err
+        }
+
+        merged := result.(TransactionWithReceipt)
+        return GetTransactionResponse{"ok", "", merged.Transaction, merged.Receipt}, nil
+    }
+}
+
+func decodeGetTransactionRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.GetTransactionRequest)
+    return GetTransactionRequest{
+        TransactionHash: req.TransactionHash,
+        WithReceipt: req.WithReceipt,
+    }, nil
+}
+
+func encodeGetTransactionResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(GetTransactionResponse)
+
+    var protoReceipt *proto.Receipt
+    if res.Receipt != nil {
+        protoReceipt = encodeReceiptGRPC(*res.Receipt)
+    }
+
+    return &proto.GetTransactionResponse{
+        Status:       res.Status,
+        ErrorMessage: res.ErrorMessage,
+        Transaction:  encodeTransactionGRPC(res.Transaction),
+        Receipt:      protoReceipt,
+    }, nil
+}
+
+func constructGetTransactionReceiptEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetTransactionReceipt(ctx, request.(string))
+        if err != nil {
+            return nil, err
+        }
+
+        return GetTransactionReceiptResponse{"ok", "", result.(Receipt)}, nil
+    }
+}
+
+func decodeGetTransactionReceiptRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.GetTransactionReceiptRequest)
+    return req.TransactionHash, nil
+}
+
+func encodeGetTransactionReceiptResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(GetTransactionReceiptResponse)
+    return &proto.GetTransactionReceiptResponse{
+        Status:       res.Status,
+        ErrorMessage: res.ErrorMessage,
+        Receipt:      encodeReceiptGRPC(res.Receipt),
+    }, nil
+}
+
+// accountStateKinds maps the proto enum onto GetAccountStateRequest kinds.
+var accountStateKinds = map[proto.AccountStateKind]string{
+    proto.AccountStateKind_BALANCE: AccountBalance,
+    proto.AccountStateKind_TRANSACTION_COUNT: AccountTransactionCount,
+    proto.AccountStateKind_CODE: AccountCode,
+    proto.AccountStateKind_STORAGE: AccountStorage,
+}
+
+type GetAccountStateResponse struct{
+    Status string
+    ErrorMessage string
+    Values []AccountValue
+}
+
+func constructGetAccountStateEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := getAccountState(ctx, svc, request.(GetAccountStateRequest))
+        if err != nil {
+            return nil, err
+        }
+
+        return GetAccountStateResponse{"ok", "", result.(AccountValuesResponse).Values}, nil
+    }
+}
+
+// decodeGetAccountStateRequestGRPC always asks for a batch, so one address
+// or many come back the same way.
+func decodeGetAccountStateRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.GetAccountStateRequest)
+
+    kind, ok := accountStateKinds[req.Kind]
+    if !ok {
+        return nil, status.Error(codes.InvalidArgument, "unknown account state kind " + req.Kind.String())
+    }
+
+    return GetAccountStateRequest{
+        Kind: kind,
+        Addresses: req.Addresses,
+        Slot: req.Slot,
+        Block: req.Block,
+        Batch: true,
+    }, nil
+}
+
+func encodeGetAccountStateResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(GetAccountStateResponse)
+
+    values := make([]*proto.AccountValue, len(res.Values))
+    for i, value := range res.Values {
+        values[i] = &proto.AccountValue{
+            Address: value.Address,
+            Block:   value.Block,
+            Slot:    value.Slot,
+            Value:   value.Value,
+        }
+    }
+
+    return &proto.GetAccountStateResponse{
+        Status:       res.Status,
+        ErrorMessage: res.ErrorMessage,
+        Values:       values,
+    }, nil
+}
+
+func constructGetLogsEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        return svc.GetLogs(ctx, request.(GetLogsRequest))
+    }
+}
+
+func decodeGetLogsRequestGRPC(req *proto.GetLogsRequest) GetLogsRequest {
+    topics := make([][]string, len(req.Topics))
+    for i, alternatives := range req.Topics {
+        topics[i] = alternatives.GetAlternatives()
+    }
+
+    return GetLogsRequest{
+        Addresses: req.Addresses,
+        Topics: topics,
+        FromBlock: req.FromBlock,
+        ToBlock: req.ToBlock,
+        BlockHash: req.BlockHash,
+        Cursor: req.Cursor,
+        Limit: int(req.Limit),
+    }
+}
+
+func encodeLogsPageGRPC(page LogsPage) *proto.GetLogsResponse {
+    return &proto.GetLogsResponse{
+        Status:     "ok",
+        Logs:       encodeLogsGRPC(page.Logs),
+        FromBlock:  page.FromBlock,
+        ToBlock:    page.ToBlock,
+        NextCursor: page.NextCursor,
+    }
+}
+
+func constructCallContractEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        return svc.CallContract(ctx, request.(CallContractRequest))
+    }
+}
+
+func decodeCallContractRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.CallContractRequest)
+
+    callReq := CallContractRequest{
+        Contract: req.Contract,
+        ABIName: req.AbiName,
+        Function: req.Function,
+        From: req.From,
+        Block: req.Block,
+    }
+    if req.Abi != "" {
+        callReq.ABI = json.RawMessage(req.Abi)
+    }
+    if req.Args != "" && json.Unmarshal([]byte(req.Args), &callReq.Args) != nil {
+        return nil, ErrInvalidRequestBody
+    }
+
+    return callReq, nil
+}
+
+func encodeCallContractResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(ContractCallResult)
+
+    outputs, err := json.Marshal(res.Outputs)
+    if err != nil {
+        return nil, ErrEncodingJSON
+    }
+
+    return &proto.CallContractResponse{
+        Status:     "ok",
+        Contract:   res.Contract,
+        Function:   res.Function,
+        Block:      res.Block,
+        Outputs:    string(outputs),
+        ReturnData: res.ReturnData,
+    }, nil
+}
+
+func constructSendRawTransactionEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        return svc.SendRawTransaction(ctx, request.(string))
+    }
+}
+
+func decodeSendRawTransactionRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    return r.(*proto.SendRawTransactionRequest).RawTransaction, nil
+}
+
+func encodeSendRawTransactionResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(SentTransaction)
+    tx := res.Transaction
+
+    accessList := []*proto.AccessTuple{}
+    for _, tuple := range tx.AccessList {
+        accessList = append(accessList, &proto.AccessTuple{Address: tuple.Address, StorageKeys: tuple.StorageKeys})
+    }
+
+    authorizations := []*proto.Authorization{}
+    for _, auth := range tx.AuthorizationList {
+        authorizations = append(authorizations, &proto.Authorization{
+            ChainId:   auth.ChainId,
+            Address:   auth.Address,
+            Nonce:     auth.Nonce,
+            YParity:   auth.YParity,
+            R:         auth.R,
+            S:         auth.S,
+            Authority: auth.Authority,
+        })
+    }
+
+    return &proto.SendRawTransactionResponse{
+        Status: "ok",
+        Hash:   res.Hash,
+        Transaction: &proto.DecodedTransaction{
+            Type:                 tx.Type,
+            Hash:                 tx.Hash,
+            From:                 tx.From,
+            ChainId:              tx.ChainId,
+            Nonce:                tx.Nonce,
+            GasPrice:             tx.GasPrice,
+            MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+            MaxFeePerGas:         tx.MaxFeePerGas,
+            Gas:                  tx.Gas,
+            To:                   tx.To,
+            Value:                tx.Value,
+            Input:                tx.Input,
+            AccessList:           accessList,
+            MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+            BlobVersionedHashes:  tx.BlobVersionedHashes,
+            AuthorizationList:    authorizations,
+            V:                    tx.V,
+            R:                    tx.R,
+            S:                    tx.S,
+            YParity:              tx.YParity,
+        },
+    }, nil
+}
+
+func encodeReceiptGRPC(receipt Receipt) *proto.Receipt {
+    return &proto.Receipt{
+        TransactionHash:   receipt.TransactionHash,
+        TransactionIndex:  receipt.TransactionIndex,
+        BlockHash:         receipt.BlockHash,
+        BlockNumber:       receipt.BlockNumber,
+        From:              receipt.From,
+        To:                receipt.To,
+        CumulativeGasUsed: receipt.CumulativeGasUsed,
+        GasUsed:           receipt.GasUsed,
+        EffectiveGasPrice: receipt.EffectiveGasPrice,
+        ContractAddress:   receipt.ContractAddress,
+        Logs:              encodeLogsGRPC(receipt.Logs),
+        LogsBloom:         receipt.LogsBloom,
+        Status:            receipt.Status,
+        Root:              receipt.Root,
+        Type:              receipt.Type,
+        BlobGasUsed:       receipt.BlobGasUsed,
+        BlobGasPrice:      receipt.BlobGasPrice,
+        TokenMovements:    encodeTokenMovementsGRPC(receipt.TokenMovements),
+    }
+}
+
+func encodeLogsGRPC(logs []Log) []*proto.Log {
+    protoLogs := []*proto.Log{}
+    for _, log := range logs {
+        protoLogs = append(protoLogs, &proto.Log{
+            Address:          log.Address,
+            Topics:           log.Topics,
+            Data:             log.Data,
+            BlockNumber:      log.BlockNumber,
+            BlockHash:        log.BlockHash,
+            TransactionHash:  log.TransactionHash,
+            TransactionIndex: log.TransactionIndex,
+            LogIndex:         log.LogIndex,
+            Removed:          log.Removed,
+        })
+    }
+    return protoLogs
+}
+
+func encodeTransactionGRPC(transaction Transaction) *proto.Transaction {
+    return &proto.Transaction{
+        BlockHash:            transaction.BlockHash,
+        BlockNumber:          transaction.BlockNumber,
+        From:                 transaction.From,
+        Gas:                  transaction.Gas,
+        GasPrice:             transaction.GasPrice,
+        Hash:                 transaction.Hash,
+        Input:                transaction.Input,
+        Nonce:                transaction.Nonce,
+        To:                   transaction.To,
+        TransactionIndex:     transaction.TransactionIndex,
+        Value:                transaction.Value,
+        V:                    transaction.V,
+        R:                    transaction.R,
+        S:                    transaction.S,
+        Type:                 transaction.Type,
+        ChainId:              transaction.ChainId,
+        MaxFeePerGas:         transaction.MaxFeePerGas,
+        MaxPriorityFeePerGas: transaction.MaxPriorityFeePerGas,
+        TokenMovements:       encodeTokenMovementsGRPC(transaction.TokenMovements),
+    }
+}
+
+func encodeTokenMovementsGRPC(movements []TokenMovement) []*proto.TokenMovement {
+    protoMovements := []*proto.TokenMovement{}
+    for _, movement := range movements {
+        protoMovements = append(protoMovements, &proto.TokenMovement{
+            Kind:          movement.Kind,
+            Source:        movement.Source,
+            Token:         movement.Token,
+            From:          movement.From,
+            To:            movement.To,
+            Spender:       movement.Spender,
+            Amount:        movement.Amount,
+            DisplayAmount: movement.DisplayAmount,
+            Symbol:        movement.Symbol,
+            Decimals:      encodeTokenDecimalsGRPC(movement.Decimals),
+            LogIndex:      movement.LogIndex,
+        })
+    }
+    return protoMovements
+}
+
+func encodeTokenDecimalsGRPC(decimals *int) uint32 {
+    if decimals == nil {
+        return 0
+    }
+    return uint32(*decimals)
+}
+
+func constructSuggestFeesEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        return svc.SuggestFees(ctx, request.(SuggestFeesRequest))
+    }
+}
+
+func decodeSuggestFeesRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.SuggestFeesRequest)
+    if req.Transaction == nil {
+        return SuggestFeesRequest{}, nil
+    }
+
+    tx := req.Transaction
+    return SuggestFeesRequest{&CallArgs{
+        From: tx.From,
+        To: tx.To,
+        Gas: tx.Gas,
+        GasPrice: tx.GasPrice,
+        MaxFeePerGas: tx.MaxFeePerGas,
+        MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+        Value: tx.Value,
+        Data: tx.Data,
+    }}, nil
+}
+
+func encodeSuggestFeesResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(FeeSuggestion)
+
+    return &proto.SuggestFeesResponse{
+        Status:        "ok",
+        BaseFeePerGas: res.BaseFeePerGas,
+        NewestBlock:   res.NewestBlock,
+        Slow:          encodeFeeTierGRPC(res.Slow),
+        Standard:      encodeFeeTierGRPC(res.Standard),
+        Fast:          encodeFeeTierGRPC(res.Fast),
+        GasEstimate:   res.GasEstimate,
+    }, nil
+}
+
+func encodeFeeTierGRPC(tier FeeTier) *proto.FeeTier {
+    return &proto.FeeTier{
+        MaxFeePerGas:         tier.MaxFeePerGas,
+        MaxPriorityFeePerGas: tier.MaxPriorityFeePerGas,
+    }
+}
+
+func constructTraceTransactionEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        return svc.TraceTransaction(ctx, request.(TraceTransactionRequest))
+    }
+}
+
+func decodeTraceTransactionRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.TraceTransactionRequest)
+    return TraceTransactionRequest{TransactionHash: req.TransactionHash, Prestate: req.Prestate}, nil
+}
+
+func encodeTraceTransactionResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(TransactionTrace)
+
+    transfers := make([]*proto.ValueTransfer, len(res.Transfers))
+    for i, transfer := range res.Transfers {
+        traceAddress := make([]uint32, len(transfer.TraceAddress))
+        for j, index := range transfer.TraceAddress {
+            traceAddress[j] = uint32(index)
+        }
+
+        transfers[i] = &proto.ValueTransfer{
+            Type:         transfer.Type,
+            From:         transfer.From,
+            To:           transfer.To,
+            Value:        transfer.Value,
+            TraceAddress: traceAddress,
+        }
+    }
+
+    var prestate map[string]*proto.PrestateAccount
+    if res.Prestate != nil {
+        prestate = make(map[string]*proto.PrestateAccount, len(res.Prestate))
+        for address, account := range res.Prestate {
+            prestate[address] = &proto.PrestateAccount{
+                Balance: account.Balance,
+                Nonce:   account.Nonce,
+                Code:    account.Code,
+                Storage: account.Storage,
+            }
+        }
+    }
+
+    return &proto.TraceTransactionResponse{
+        Status:          "ok",
+        TransactionHash: res.TransactionHash,
+        Call:            encodeCallFrameGRPC(res.Call),
+        Transfers:       transfers,
+        Prestate:        prestate,
+    }, nil
+}
+
+func encodeCallFrameGRPC(frame CallFrame) *proto.CallFrame {
+    calls := make([]*proto.CallFrame, len(frame.Calls))
+    for i, call := range frame.Calls {
+        calls[i] = encodeCallFrameGRPC(call)
+    }
+
+    return &proto.CallFrame{
+        Type:         frame.Type,
+        From:         frame.From,
+        To:           frame.To,
+        Value:        frame.Value,
+        Gas:          frame.Gas,
+        GasUsed:      frame.GasUsed,
+        Input:        frame.Input,
+        Output:       frame.Output,
+        Error:        frame.Error,
+        RevertReason: frame.RevertReason,
+        Calls:        calls,
+    }
+}
+
+func constructGetTokenBalanceEndpointGRPC(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        return svc.GetTokenBalance(ctx, request.(GetTokenBalanceRequest))
+    }
+}
+
+func decodeGetTokenBalanceRequestGRPC(_ context.Context, r interface{}) (interface{}, error) {
+    req := r.(*proto.GetTokenBalanceRequest)
+    return GetTokenBalanceRequest{Token: req.Token, Address: req.Address, Block: req.Block}, nil
+}
+
+func encodeGetTokenBalanceResponseGRPC(_ context.Context, result interface{}) (interface{}, error) {
+    res := result.(TokenBalance)
+
+    return &proto.GetTokenBalanceResponse{
+        Status:         "ok",
+        Token:          res.Token,
+        Address:        res.Address,
+        Block:          res.Block,
+        Balance:        res.Balance,
+        DisplayBalance: res.DisplayBalance,
+        Name:           res.Name,
+        Symbol:         res.Symbol,
+        Decimals:       encodeTokenDecimalsGRPC(res.Decimals),
+    }, nil
+}
+
+// grpcStatusFromError maps service and node errors onto gRPC status codes.
+func grpcStatusFromError(err error) error {
+    if _, ok := status.FromError(err); ok {
+        return err
+    }
+
+    code := codes.Internal
+    if rpcErr, ok := err.(*RPCError); ok {
+        switch rpcErr.Code {
+        case RPCErrInvalidParams:
+            code = codes.InvalidArgument
+        case RPCErrMethodNotFound:
+            code = codes.Unimplemented
+        case RPCErrLimitExceeded:
+            code = codes.ResourceExhausted
+            if isTooManyResults(rpcErr) {
+                code = codes.FailedPrecondition
+            }
+        case RPCErrExecutionReverted:
+            code = codes.FailedPrecondition
+        }
+        return status.Error(code, err.Error())
+    }
+
+    if _, ok := err.(*HTTPStatusError); ok {
+        return status.Error(codes.Unavailable, err.Error())
+    }
+
+    if _, ok := err.(*ABIArgumentError); ok {
+        return status.Error(codes.InvalidArgument, err.Error())
+    }
+
+    // Rejections carry their reason and details as a TransactionRejection.
+    if rejectErr, ok := err.(*TransactionRejectedError); ok {
+        code = codes.FailedPrecondition
+        if rejectErr.isMalformed() {
+            code = codes.InvalidArgument
+        }
+
+        st, detailErr := status.New(code, err.Error()).WithDetails(&proto.TransactionRejection{
+            Reason:  rejectErr.Reason,
+            Message: rejectErr.Message,
+            Details: rejectErr.Details,
+        })
+        if detailErr != nil {
+            return status.Error(code, err.Error())
+        }
+        return st.Err()
+    }
+
+    switch err {
+    case ErrInvalidBlockHash, ErrInvalidBlockNumber, ErrInvalidTransactionHash, ErrInvalidBoolParam,
+        ErrInvalidAddress, ErrInvalidStorageSlot, ErrNoAddresses, ErrTooManyAddresses,
+        ErrInvalidTopic, ErrInvalidBlockRange, ErrInvalidCursor, ErrInvalidLimit,
+        ErrInvalidRequestBody, ErrInvalidABI, ErrInvalidABIName, ErrMissingABI, ErrUnknownFunction,
+        ErrAmbiguousFunction, ErrWrongArgumentCount:
+        code = codes.InvalidArgument
+    case ErrNullResult, ErrNoUpstreamStatus, ErrUnknownABI:
+        code = codes.NotFound
+    case ErrEmptyCallResult, ErrDecodingABI, ErrUnresolvedBlockTag:
+        code = codes.FailedPrecondition
+    case ErrFixedABI:
+        code = codes.AlreadyExists
+    case ErrMissingDebugToken:
+        code = codes.Unauthenticated
+    case ErrDebugDisabled, ErrInvalidDebugToken:
+        code = codes.PermissionDenied
+    case ErrTooManyTraces, ErrTooManyABIs:
+        code = codes.ResourceExhausted
+    case ErrUnrecordedCall:
+        // A strict replay cannot serve calls missing from its recording.
+        code = codes.Unimplemented
+    case ErrConnectingToGeth, ErrReadingGethResponse, ErrUpstreamUnavailable, ErrQuorumUnavailable,
+        ErrParsingJSON, ErrParsingInt, ErrBatchMismatch, ErrResponseIdMismatch, ErrImplausibleUpstreamResponse:
+        code = codes.Unavailable
+    case ErrInconsistentUpstreams:
+        code = codes.Aborted
+    case context.DeadlineExceeded:
+        code = codes.DeadlineExceeded
+    case context.Canceled:
+        code = codes.Canceled
+    }
+    return status.Error(code, err.Error())
+}
 
 type GRPCServer struct {
-    getSync            gt.Handler
-    getTxsForBlockHash gt.Handler
+    getSync               gt.Handler
+    getTxsForBlockHash    gt.Handler
+    getBlock              gt.Handler
+    getTransaction        gt.Handler
+    getTransactionReceipt gt.Handler
+    getAccountState       gt.Handler
+    // go-kit has no streaming transport, so StreamLogs calls the endpoint
+    // itself, once per page.
+    getLogs               endpoint.Endpoint
+    callContract          gt.Handler
+    sendRawTransaction    gt.Handler
+    suggestFees           gt.Handler
+    traceTransaction      gt.Handler
+    getTokenBalance       gt.Handler
 }
 
 func (s *GRPCServer) GetTxsForBlockHash(ctx context.Context, req *proto.GetTxsForBlockHashRequest) (*proto.GetTxsForBlockHashResponse, error) {
-    _, resp, err := s.getSync.ServeGRPC(ctx, req)
+    _, resp, err := s.getTxsForBlockHash.ServeGRPC(ctx, req)
     if err != nil {
-        return nil, err
+        return nil, grpcStatusFromError(err)
     }
     return resp.(*proto.GetTxsForBlockHashResponse), nil
 }
 
 func (s *GRPCServer) GetSync(ctx context.Context, req *proto.GetSyncRequest) (*proto.GetSyncResponse, error) {
-    _, resp, err := s.getTxsForBlockHash.ServeGRPC(ctx, req)
+    _, resp, err := s.getSync.ServeGRPC(ctx, req)
     if err != nil {
-        return nil, err
+        return nil, grpcStatusFromError(err)
     }
     return resp.(*proto.GetSyncResponse), nil
 }
 
-func GetGethGRPCEndpoints(_ context.Context, ethService EthService) proto.EthGRPCServer {
+func (s *GRPCServer) GetBlock(ctx context.Context, req *proto.GetBlockRequest) (*proto.GetBlockResponse, error) {
+    _, resp, err := s.getBlock.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.GetBlockResponse), nil
+}
+
+func (s *GRPCServer) GetTransaction(ctx context.Context, req *proto.GetTransactionRequest) (*proto.GetTransactionResponse, error) {
+    _, resp, err := s.getTransaction.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.GetTransactionResponse), nil
+}
+
+func (s *GRPCServer) GetTransactionReceipt(ctx context.Context, req *proto.GetTransactionReceiptRequest) (*proto.GetTransactionReceiptResponse, error) {
+    _, resp, err := s.getTransactionReceipt.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.GetTransactionReceiptResponse), nil
+}
+
+func (s *GRPCServer) GetAccountState(ctx context.Context, req *proto.GetAccountStateRequest) (*proto.GetAccountStateResponse, error) {
+    _, resp, err := s.getAccountState.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.GetAccountStateResponse), nil
+}
+
+func (s *GRPCServer) CallContract(ctx context.Context, req *proto.CallContractRequest) (*proto.CallContractResponse, error) {
+    _, resp, err := s.callContract.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.CallContractResponse), nil
+}
+
+func (s *GRPCServer) SuggestFees(ctx context.Context, req *proto.SuggestFeesRequest) (*proto.SuggestFeesResponse, error) {
+    _, resp, err := s.suggestFees.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.SuggestFeesResponse), nil
+}
+
+func (s *GRPCServer) SendRawTransaction(ctx context.Context, req *proto.SendRawTransactionRequest) (*proto.SendRawTransactionResponse, error) {
+    _, resp, err := s.sendRawTransaction.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.SendRawTransactionResponse), nil
+}
+
+func (s *GRPCServer) TraceTransaction(ctx context.Context, req *proto.TraceTransactionRequest) (*proto.TraceTransactionResponse, error) {
+    _, resp, err := s.traceTransaction.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.TraceTransactionResponse), nil
+}
+
+func (s *GRPCServer) GetTokenBalance(ctx context.Context, req *proto.GetTokenBalanceRequest) (*proto.GetTokenBalanceResponse, error) {
+    _, resp, err := s.getTokenBalance.ServeGRPC(ctx, req)
+    if err != nil {
+        return nil, grpcStatusFromError(err)
+    }
+    return resp.(*proto.GetTokenBalanceResponse), nil
+}
+
+// StreamLogs sends the logs page by page, following the cursor until the
+// requested range is exhausted. Every page carries its cursor, so a client
+// can resume a broken stream from the last page it received.
+func (s *GRPCServer) StreamLogs(req *proto.GetLogsRequest, stream proto.EthGRPC_StreamLogsServer) error {
+    ctx := stream.Context()
+    md, _ := metadata.FromIncomingContext(ctx)
+    ctx = requestIdFromGRPC(ctx, md)
+    ctx = quorumFromGRPC(ctx, md)
+    stream.SetHeader(metadata.Pairs(requestIdMetadataKey, RequestIdFromContext(ctx)))
+
+    logsReq := decodeGetLogsRequestGRPC(req)
+    for {
+        resp, err := s.getLogs(ctx, logsReq)
+        if err != nil {
+            return grpcStatusFromError(err)
+        }
+
+        page := resp.(LogsPage)
+        err = stream.Send(encodeLogsPageGRPC(page))
+        if err != nil {
+            return err
+        }
+
+        if page.NextCursor == "" {
+            return nil
+        }
+        logsReq.Cursor = page.NextCursor
+    }
+}
+
+// GetGethGRPCEndpoints builds the gRPC server. middlewares wrap every
+// endpoint that calls the node, the first one outermost.
+func GetGethGRPCEndpoints(_ context.Context, ethService EthService, middlewares ...endpoint.Middleware) proto.EthGRPCServer {
+    options := []gt.ServerOption{
+        gt.ServerBefore(requestIdFromGRPC, quorumFromGRPC, debugTokenFromGRPC),
+        gt.ServerAfter(requestIdToGRPC),
+    }
+
     return &GRPCServer{
         getSync: gt.NewServer(
-            constructGetSyncEndpointGPRC(ethService),
+            applyMiddlewares(constructGetSyncEndpointGPRC(ethService), middlewares),
             decodeGetSyncRequestGRPC,
             encodeGetSyncResponseGPRC,
+            options...,
         ),
         getTxsForBlockHash: gt.NewServer(
-            constructGetBlockHashTxsEndpointGRPC(ethService),
+            applyMiddlewares(constructGetBlockHashTxsEndpointGRPC(ethService), middlewares),
             decodeGetBlockHashTxsRequestGPRC,
             encodeGetBlockHashTxsResponseGPRC,
+            options...,
+        ),
+        getBlock: gt.NewServer(
+            applyMiddlewares(constructGetBlockEndpointGRPC(ethService), middlewares),
+            decodeGetBlockRequestGRPC,
+            encodeGetBlockResponseGRPC,
+            options...,
+        ),
+        getTransaction: gt.NewServer(
+            applyMiddlewares(constructGetTransactionEndpointGRPC(ethService), middlewares),
+            decodeGetTransactionRequestGRPC,
+            encodeGetTransactionResponseGRPC,
+            options...,
+        ),
+        getTransactionReceipt: gt.NewServer(
+            applyMiddlewares(constructGetTransactionReceiptEndpointGRPC(ethService), middlewares),
+            decodeGetTransactionReceiptRequestGRPC,
+            encodeGetTransactionReceiptResponseGRPC,
+            options...,
+        ),
+        getAccountState: gt.NewServer(
+            applyMiddlewares(constructGetAccountStateEndpointGRPC(ethService), middlewares),
+            decodeGetAccountStateRequestGRPC,
+            encodeGetAccountStateResponseGRPC,
+            options...,
+        ),
+        getLogs: applyMiddlewares(constructGetLogsEndpointGRPC(ethService), middlewares),
+        callContract: gt.NewServer(
+            applyMiddlewares(constructCallContractEndpointGRPC(ethService), middlewares),
+            decodeCallContractRequestGRPC,
+            encodeCallContractResponseGRPC,
+            options...,
+        ),
+        sendRawTransaction: gt.NewServer(
+            applyMiddlewares(constructSendRawTransactionEndpointGRPC(ethService), middlewares),
+            decodeSendRawTransactionRequestGRPC,
+            encodeSendRawTransactionResponseGRPC,
+            options...,
+        ),
+        suggestFees: gt.NewServer(
+            applyMiddlewares(constructSuggestFeesEndpointGRPC(ethService), middlewares),
+            decodeSuggestFeesRequestGRPC,
+            encodeSuggestFeesResponseGRPC,
+            options...,
+        ),
+        traceTransaction: gt.NewServer(
+            applyMiddlewares(constructTraceTransactionEndpointGRPC(ethService), middlewares),
+            decodeTraceTransactionRequestGRPC,
+            encodeTraceTransactionResponseGRPC,
+            options...,
+        ),
+        getTokenBalance: gt.NewServer(
+            applyMiddlewares(constructGetTokenBalanceEndpointGRPC(ethService), middlewares),
+            decodeGetTokenBalanceRequestGRPC,
+            encodeGetTokenBalanceResponseGRPC,
+            options...,
         ),
     }
 }
\ No newline at end of file
@@ -2,29 +2,144 @@ package router
 
 import (
     "context"
+    "expvar"
     "encoding/json"
+    "fmt"
+    "io/ioutil"
     "log"
     "net/http"
+    "strconv"
+    "strings"
     "github.com/go-kit/kit/endpoint"
     "github.com/gorilla/mux"
     httptransport "github.com/go-kit/kit/transport/http"
 )
 
-func generateErrorResponse(errorMessage string) ([]byte) {
-    return []byte("{\"status\":\"error\", \"errorMessage\":\"" + errorMessage + "\"}")
+// statusClientClosedRequest is the non-standard status nginx logs for
+// requests the client gave up on. The client never sees it.
+const statusClientClosedRequest int = 499
+
+// MaxRequestBodySize bounds the JSON bodies of POST requests. The largest a
+// caller needs is a blob transaction with its sidecars, under 2 MB in hex.
+const MaxRequestBodySize int64 = 4 * 1024 * 1024
+
+type ErrorResponse struct {
+    Status string `json:"status"`
+    ErrorMessage string `json:"errorMessage"`
+    ErrorCode int `json:"errorCode,omitempty"`
+    ErrorReason string `json:"errorReason,omitempty"`
+    ErrorData json.RawMessage `json:"errorData,omitempty"`
+}
+
+func generateErrorResponse(err error) ([]byte) {
+    errResp := ErrorResponse{Status: "error", ErrorMessage: err.Error()}
+    if rpcErr, ok := err.(*RPCError); ok {
+        errResp.ErrorMessage = rpcErr.Message
+        errResp.ErrorCode = rpcErr.Code
+        errResp.ErrorData = rpcErr.Data
+    }
+    if rejectErr, ok := err.(*TransactionRejectedError); ok {
+        errResp.ErrorReason = rejectErr.Reason
+        if len(rejectErr.Details) > 0 {
+            errResp.ErrorData, _ = json.Marshal(rejectErr.Details)
+        }
+    }
+
+    jsonData, _ := json.Marshal(errResp)
+    return jsonData
+}
+
+// httpStatusFromError maps service and node errors onto HTTP status codes.
+func httpStatusFromError(err error) int {
+    if rpcErr, ok := err.(*RPCError); ok {
+        switch rpcErr.Code {
+        case RPCErrInvalidParams:
+            return http.StatusBadRequest
+        case RPCErrMethodNotFound:
+            return http.StatusNotImplemented
+        case RPCErrLimitExceeded:
+            if isTooManyResults(rpcErr) {
+                return http.StatusUnprocessableEntity
+            }
+            return http.StatusTooManyRequests
+        case RPCErrExecutionReverted:
+            return http.StatusUnprocessableEntity
+        }
+        return http.StatusBadGateway
+    }
+
+    if _, ok := err.(*HTTPStatusError); ok {
+        return http.StatusBadGateway
+    }
+
+    if _, ok := err.(*ABIArgumentError); ok {
+        return http.StatusBadRequest
+    }
+
+    if rejectErr, ok := err.(*TransactionRejectedError); ok {
+        if rejectErr.isMalformed() {
+            return http.StatusBadRequest
+        }
+        return http.StatusUnprocessableEntity
+    }
+
+    switch err {
+    case ErrInvalidBlockHash, ErrInvalidBlockNumber, ErrInvalidTransactionHash, ErrInvalidBoolParam,
+        ErrInvalidAddress, ErrInvalidStorageSlot, ErrNoAddresses, ErrTooManyAddresses,
+        ErrInvalidTopic, ErrInvalidBlockRange, ErrInvalidCursor, ErrInvalidLimit,
+        ErrInvalidRequestBody, ErrInvalidABI, ErrInvalidABIName, ErrMissingABI, ErrUnknownFunction,
+        ErrAmbiguousFunction, ErrWrongArgumentCount:
+        return http.StatusBadRequest
+    case ErrNullResult, ErrNoUpstreamStatus, ErrUnknownABI:
+        return http.StatusNotFound
+    case ErrEmptyCallResult, ErrDecodingABI, ErrUnresolvedBlockTag:
+        return http.StatusUnprocessableEntity
+    case ErrFixedABI:
+        return http.StatusConflict
+    case ErrMissingDebugToken:
+        return http.StatusUnauthorized
+    case ErrDebugDisabled, ErrInvalidDebugToken:
+        return http.StatusForbidden
+    case ErrTooManyTraces:
+        return http.StatusTooManyRequests
+    case ErrTooManyABIs:
+        return http.StatusInsufficientStorage
+    case ErrRequestBodyTooLarge:
+        return http.StatusRequestEntityTooLarge
+    case ErrUnrecordedCall:
+        return http.StatusNotImplemented
+    case ErrConnectingToGeth, ErrUpstreamUnavailable, ErrQuorumUnavailable:
+        return http.StatusServiceUnavailable
+    case ErrReadingGethResponse, ErrParsingJSON, ErrParsingInt, ErrBatchMismatch, ErrResponseIdMismatch, ErrInconsistentUpstreams,
+        ErrImplausibleUpstreamResponse:
+        return http.StatusBadGateway
+    case context.DeadlineExceeded:
+        return http.StatusGatewayTimeout
+    case context.Canceled:
+        return statusClientClosedRequest
+    }
+    return http.StatusInternalServerError
+}
+
+func encodeErrorResponseHTTP(ctx context.Context, err error, w http.ResponseWriter) {
+    logUpstream(ctx, "Sending error response: " + err.Error())
+    w.Header().Set("Content-Type", "application/json")
+    w.Header().Set(RequestIdHeader, RequestIdFromContext(ctx))
+    w.WriteHeader(httpStatusFromError(err))
+    w.Write(generateErrorResponse(err))
 }
 
 func constructGetBlockHashTxsEndpointHTTP(svc EthService) endpoint.Endpoint {
-    return func(_ context.Context, request interface{}) (interface{}, error) {
-        result, err := svc.GetTransactions(request.(string))
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetTransactions(ctx, request.(string))
         if err != nil {
-            return generateErrorResponse(err.Error()), nil
+            return nil, err
         }
 
         var jsonData []byte
         jsonData, err = json.Marshal(result.(TransactionResultsResponse))
         if err != nil {
-            return generateErrorResponse(ErrEncodingJSON.Error()), nil
+            return nil, ErrEncodingJSON
         }
 
         return jsonData, nil
@@ -44,16 +159,16 @@ func decodeBlockHashTxsResponseHTTP(_ context.Context, w http.ResponseWriter, re
 }
 
 func constructGetSyncStatusEndpointHTTP(svc EthService) endpoint.Endpoint {
-    return func(_ context.Context, request interface{}) (interface{}, error) {
-        result, err := svc.GetSyncStatus()
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetSyncStatus(ctx)
         if err != nil {
-            return generateErrorResponse(err.Error()), nil
+            return nil, err
         }
 
         var jsonData []byte
         jsonData, err = json.Marshal(result.(BlockSyncProgress))
         if err != nil {
-            return generateErrorResponse(ErrEncodingJSON.Error()), nil
+            return nil, ErrEncodingJSON
         }
 
         return jsonData, nil
@@ -71,22 +186,688 @@ func encodeGetSyncResponseHTTP(_ context.Context, w http.ResponseWriter, respons
     return err
 }
 
-func GenerateHTTPRouter(ethService EthService) interface{} {
+func constructGetUpstreamStatusEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetUpstreamStatus(ctx)
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(PoolStatus))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeGetUpstreamStatusRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    log.Println("Receiving GetUpstreamStatus Request")
+    return true, nil
+}
+
+func encodeGetUpstreamStatusResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructGetBlockEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        req := request.(GetBlockRequest)
+
+        var result interface{}
+        var err error
+        if req.BlockHash != "" {
+            result, err = svc.GetBlockByHash(ctx, req.BlockHash, req.FullTransactions)
+        } else {
+            result, err = svc.GetBlockByNumber(ctx, req.BlockNumber, req.FullTransactions)
+        }
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(Block))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeGetBlockByHashRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    vars := mux.Vars(r)
+    log.Println("Receiving GetBlockByHash Request for Hash: " + vars["blockHash"])
+
+    fullTransactions, err := boolQueryParam(r, "fullTransactions")
+    if err != nil {
+        return nil, err
+    }
+
+    return GetBlockRequest{BlockHash: vars["blockHash"], FullTransactions: fullTransactions}, nil
+}
+
+func decodeGetBlockByNumberRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    vars := mux.Vars(r)
+    log.Println("Receiving GetBlockByNumber Request for Number: " + vars["blockNumber"])
+
+    fullTransactions, err := boolQueryParam(r, "fullTransactions")
+    if err != nil {
+        return nil, err
+    }
+
+    return GetBlockRequest{BlockNumber: vars["blockNumber"], FullTransactions: fullTransactions}, nil
+}
+
+func encodeGetBlockResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending GetBlock Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructGetTransactionEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        req := request.(GetTransactionRequest)
+
+        var result interface{}
+        var err error
+        if req.WithReceipt {
+            result, err = svc.GetTransactionWithReceipt(ctx, req.TransactionHash)
+        } else {
+            result, err = svc.GetTransactionByHash(ctx, req.TransactionHash)
+        }
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result)
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeGetTransactionRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    vars := mux.Vars(r)
+    log.Println("Receiving GetTransaction Request for Hash: " + vars["txHash"])
+
+    withReceipt, err := boolQueryParam(r, "withReceipt")
+    if err != nil {
+        return nil, err
+    }
+
+    return GetTransactionRequest{TransactionHash: vars["txHash"], WithReceipt: withReceipt}, nil
+}
+
+func encodeGetTransactionResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending GetTransaction Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructGetTransactionReceiptEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetTransactionReceipt(ctx, request.(string))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(Receipt))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeGetTransactionReceiptRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    vars := mux.Vars(r)
+    log.Println("Receiving GetTransactionReceipt Request for Hash: " + vars["txHash"])
+    return vars["txHash"], nil
+}
+
+func encodeGetTransactionReceiptResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending GetTransactionReceipt Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructGetAccountStateEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := getAccountState(ctx, svc, request.(GetAccountStateRequest))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result)
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+// decodeGetAccountStateRequestHTTP reads the address, and the slot of
+// storage queries, from the path. Batched routes take a comma separated
+// addresses query parameter instead. Both take an optional block.
+func decodeGetAccountStateRequestHTTP(kind string, batch bool) httptransport.DecodeRequestFunc {
+    return func(_ context.Context, r *http.Request) (interface{}, error) {
+        vars := mux.Vars(r)
+        query := r.URL.Query()
+        req := GetAccountStateRequest{Kind: kind, Slot: vars["slot"], Block: query.Get("block"), Batch: batch}
+
+        if batch {
+            if req.Slot == "" {
+                req.Slot = query.Get("slot")
+            }
+            req.Addresses = listQueryParam(r, "addresses")
+        } else {
+            req.Addresses = []string{vars["address"]}
+        }
+
+        log.Println("Receiving GetAccountState Request for " + kind + " of: " + strings.Join(req.Addresses, ","))
+        return req, nil
+    }
+}
+
+func encodeGetAccountStateResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending GetAccountState Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructGetLogsEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetLogs(ctx, request.(GetLogsRequest))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(LogsPage))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+// decodeGetLogsRequestHTTP takes comma separated addresses, and topic0 to
+// topic3 each listing the alternatives for that topic, empty for any.
+func decodeGetLogsRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    query := r.URL.Query()
+    req := GetLogsRequest{
+        Addresses: listQueryParam(r, "address"),
+        FromBlock: query.Get("fromBlock"),
+        ToBlock: query.Get("toBlock"),
+        BlockHash: query.Get("blockHash"),
+        Cursor: query.Get("cursor"),
+    }
+
+    for i := 0; i < 4; i++ {
+        name := "topic" + strconv.Itoa(i)
+        if _, ok := query[name]; ok {
+            for len(req.Topics) < i {
+                req.Topics = append(req.Topics, []string{})
+            }
+            req.Topics = append(req.Topics, listQueryParam(r, name))
+        }
+    }
+
+    if limit := query.Get("limit"); limit != "" {
+        var err error
+        req.Limit, err = strconv.Atoi(limit)
+        if err != nil || req.Limit <= 0 {
+            return nil, ErrInvalidLimit
+        }
+    }
+
+    log.Println("Receiving GetLogs Request for: " + r.URL.RawQuery)
+    return req, nil
+}
+
+func encodeGetLogsResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending GetLogs Response of " + strconv.Itoa(len(response.([]byte))) + " bytes")
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructCallContractEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.CallContract(ctx, request.(CallContractRequest))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(ContractCallResult))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeCallContractRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    var req CallContractRequest
+    err := decodeJSONBody(r, &req)
+    if err != nil {
+        return nil, err
+    }
+
+    log.Println("Receiving CallContract Request for: " + req.Contract + " " + req.Function)
+    return req, nil
+}
+
+func encodeCallContractResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending CallContract Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructRegisterABIEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        req := request.(RegisterABIRequest)
+        result, err := svc.RegisterABI(ctx, req.Name, req.ABI)
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(RegisteredABI))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeRegisterABIRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    vars := mux.Vars(r)
+    log.Println("Receiving RegisterABI Request for: " + vars["name"])
+
+    var abi json.RawMessage
+    err := decodeJSONBody(r, &abi)
+    if err != nil {
+        return nil, err
+    }
+
+    return RegisterABIRequest{vars["name"], abi}, nil
+}
+
+func encodeRegisterABIResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending RegisterABI Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructSendRawTransactionEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.SendRawTransaction(ctx, request.(string))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(SentTransaction))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeSendRawTransactionRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    var req SendRawTransactionRequest
+    err := decodeJSONBody(r, &req)
+    if err != nil {
+        return nil, err
+    }
+
+    log.Println("Receiving SendRawTransaction Request of " + strconv.Itoa(len(req.RawTransaction)) + " hex digits")
+    return req.RawTransaction, nil
+}
+
+func encodeSendRawTransactionResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending SendRawTransaction Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructSuggestFeesEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.SuggestFees(ctx, request.(SuggestFeesRequest))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(FeeSuggestion))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+// decodeSuggestFeesRequestHTTP takes the transaction to estimate from the
+// body of a POST, a GET only asks for fees.
+func decodeSuggestFeesRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    log.Println("Receiving SuggestFees Request")
+
+    var req SuggestFeesRequest
+    if r.Method == "POST" {
+        err := decodeJSONBody(r, &req)
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    return req, nil
+}
+
+func encodeSuggestFeesResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending SuggestFees Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructTraceTransactionEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.TraceTransaction(ctx, request.(TraceTransactionRequest))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(TransactionTrace))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeTraceTransactionRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    vars := mux.Vars(r)
+    log.Println("Receiving TraceTransaction Request for Hash: " + vars["txHash"])
+
+    prestate, err := boolQueryParam(r, "prestate")
+    if err != nil {
+        return nil, err
+    }
+
+    return TraceTransactionRequest{TransactionHash: vars["txHash"], Prestate: prestate}, nil
+}
+
+func encodeTraceTransactionResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending TraceTransaction Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+func constructGetTokenBalanceEndpointHTTP(svc EthService) endpoint.Endpoint {
+    return func(ctx context.Context, request interface{}) (interface{}, error) {
+        result, err := svc.GetTokenBalance(ctx, request.(GetTokenBalanceRequest))
+        if err != nil {
+            return nil, err
+        }
+
+        var jsonData []byte
+        jsonData, err = json.Marshal(result.(TokenBalance))
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        return jsonData, nil
+    }
+}
+
+func decodeGetTokenBalanceRequestHTTP(_ context.Context, r *http.Request) (interface{}, error){
+    vars := mux.Vars(r)
+    log.Println("Receiving GetTokenBalance Request for " + vars["address"] + " of token: " + vars["token"])
+
+    return GetTokenBalanceRequest{Token: vars["token"], Address: vars["address"], Block: r.URL.Query().Get("block")}, nil
+}
+
+func encodeGetTokenBalanceResponseHTTP(_ context.Context, w http.ResponseWriter, response interface{}) error {
+    log.Println("Sending GetTokenBalance Response: " + string(response.([]byte)))
+    _, err := w.Write(response.([]byte))
+    return err
+}
+
+// listQueryParam reads a query parameter holding a comma separated list,
+// possibly repeated.
+func listQueryParam(r *http.Request, name string) []string {
+    list := []string{}
+    for _, values := range r.URL.Query()[name] {
+        for _, value := range strings.Split(values, ",") {
+            if value != "" {
+                list = append(list, value)
+            }
+        }
+    }
+    return list
+}
+
+// boolQueryParam reads an optional true/false query parameter.
+func boolQueryParam(r *http.Request, name string) (bool, error) {
+    value := r.URL.Query().Get(name)
+    if value == "" {
+        return false, nil
+    }
+
+    flag, err := strconv.ParseBool(value)
+    if err != nil {
+        return false, ErrInvalidBoolParam
+    }
+
+    return flag, nil
+}
+
+// GenerateHTTPRouter builds the mux router. middlewares wrap every endpoint
+// that calls the node, the first one outermost.
+func GenerateHTTPRouter(ethService EthService, middlewares ...endpoint.Middleware) interface{} {
+    options := []httptransport.ServerOption{
+        httptransport.ServerBefore(requestIdFromHTTP, quorumFromHTTP, debugTokenFromHTTP),
+        httptransport.ServerAfter(requestIdToHTTP),
+        httptransport.ServerErrorEncoder(encodeErrorResponseHTTP),
+    }
+
     addressHandler := httptransport.NewServer(
-        constructGetBlockHashTxsEndpointHTTP(ethService),
+        applyMiddlewares(constructGetBlockHashTxsEndpointHTTP(ethService), middlewares),
         decodeBlockHashTxsRequestHTTP,
         decodeBlockHashTxsResponseHTTP,
+        options...,
     )
 
     getSyncHandler := httptransport.NewServer(
-        constructGetSyncStatusEndpointHTTP(ethService),
+        applyMiddlewares(constructGetSyncStatusEndpointHTTP(ethService), middlewares),
         decodeGetSyncRequestHTTP,
         encodeGetSyncResponseHTTP,
+        options...,
+    )
+
+    getUpstreamStatusHandler := httptransport.NewServer(
+        constructGetUpstreamStatusEndpointHTTP(ethService),
+        decodeGetUpstreamStatusRequestHTTP,
+        encodeGetUpstreamStatusResponseHTTP,
+        options...,
+    )
+
+    getBlockByHashHandler := httptransport.NewServer(
+        applyMiddlewares(constructGetBlockEndpointHTTP(ethService), middlewares),
+        decodeGetBlockByHashRequestHTTP,
+        encodeGetBlockResponseHTTP,
+        options...,
+    )
+
+    getBlockByNumberHandler := httptransport.NewServer(
+        applyMiddlewares(constructGetBlockEndpointHTTP(ethService), middlewares),
+        decodeGetBlockByNumberRequestHTTP,
+        encodeGetBlockResponseHTTP,
+        options...,
+    )
+
+    getTransactionHandler := httptransport.NewServer(
+        applyMiddlewares(constructGetTransactionEndpointHTTP(ethService), middlewares),
+        decodeGetTransactionRequestHTTP,
+        encodeGetTransactionResponseHTTP,
+        options...,
+    )
+
+    getTransactionReceiptHandler := httptransport.NewServer(
+        applyMiddlewares(constructGetTransactionReceiptEndpointHTTP(ethService), middlewares),
+        decodeGetTransactionReceiptRequestHTTP,
+        encodeGetTransactionReceiptResponseHTTP,
+        options...,
+    )
+
+    accountStateHandler := func(kind string, batch bool) http.Handler {
+        return httptransport.NewServer(
+            applyMiddlewares(constructGetAccountStateEndpointHTTP(ethService), middlewares),
+            decodeGetAccountStateRequestHTTP(kind, batch),
+            encodeGetAccountStateResponseHTTP,
+            options...,
+        )
+    }
+
+    getLogsHandler := httptransport.NewServer(
+        applyMiddlewares(constructGetLogsEndpointHTTP(ethService), middlewares),
+        decodeGetLogsRequestHTTP,
+        encodeGetLogsResponseHTTP,
+        options...,
+    )
+
+    callContractHandler := httptransport.NewServer(
+        applyMiddlewares(constructCallContractEndpointHTTP(ethService), middlewares),
+        decodeCallContractRequestHTTP,
+        encodeCallContractResponseHTTP,
+        options...,
+    )
+
+    registerABIHandler := httptransport.NewServer(
+        constructRegisterABIEndpointHTTP(ethService),
+        decodeRegisterABIRequestHTTP,
+        encodeRegisterABIResponseHTTP,
+        options...,
+    )
+
+    sendRawTransactionHandler := httptransport.NewServer(
+        applyMiddlewares(constructSendRawTransactionEndpointHTTP(ethService), middlewares),
+        decodeSendRawTransactionRequestHTTP,
+        encodeSendRawTransactionResponseHTTP,
+        options...,
+    )
+
+    suggestFeesHandler := httptransport.NewServer(
+        applyMiddlewares(constructSuggestFeesEndpointHTTP(ethService), middlewares),
+        decodeSuggestFeesRequestHTTP,
+        encodeSuggestFeesResponseHTTP,
+        options...,
+    )
+
+    traceTransactionHandler := httptransport.NewServer(
+        applyMiddlewares(constructTraceTransactionEndpointHTTP(ethService), middlewares),
+        decodeTraceTransactionRequestHTTP,
+        encodeTraceTransactionResponseHTTP,
+        options...,
+    )
+
+    getTokenBalanceHandler := httptransport.NewServer(
+        applyMiddlewares(constructGetTokenBalanceEndpointHTTP(ethService), middlewares),
+        decodeGetTokenBalanceRequestHTTP,
+        encodeGetTokenBalanceResponseHTTP,
+        options...,
     )
 
     router := mux.NewRouter()
     router.Methods("GET").PathPrefix("/getBlockHashTransactions/{blockHash}").Handler(addressHandler)
     router.Methods("GET").PathPrefix("/getSyncStatus/").Handler(getSyncHandler)
+    router.Methods("GET").Path("/getBlockByHash/{blockHash}").Handler(getBlockByHashHandler)
+    router.Methods("GET").Path("/getBlockByNumber/{blockNumber}").Handler(getBlockByNumberHandler)
+    router.Methods("GET").Path("/getTransaction/{txHash}").Handler(getTransactionHandler)
+    router.Methods("GET").Path("/getTransactionReceipt/{txHash}").Handler(getTransactionReceiptHandler)
+    router.Methods("GET").Path("/getBalance/{address}").Handler(accountStateHandler(AccountBalance, false))
+    router.Methods("GET").Path("/getTransactionCount/{address}").Handler(accountStateHandler(AccountTransactionCount, false))
+    router.Methods("GET").Path("/getCode/{address}").Handler(accountStateHandler(AccountCode, false))
+    router.Methods("GET").Path("/getStorageAt/{address}/{slot}").Handler(accountStateHandler(AccountStorage, false))
+    router.Methods("GET").Path("/getBalances").Handler(accountStateHandler(AccountBalance, true))
+    router.Methods("GET").Path("/getTransactionCounts").Handler(accountStateHandler(AccountTransactionCount, true))
+    router.Methods("GET").Path("/getCodes").Handler(accountStateHandler(AccountCode, true))
+    router.Methods("GET").Path("/getStorageAt").Handler(accountStateHandler(AccountStorage, true))
+    router.Methods("GET").Path("/getLogs").Handler(getLogsHandler)
+    router.Methods("POST").Path("/callContract").Handler(limitRequestBody(callContractHandler))
+    router.Methods("POST").Path("/abis/{name}").Handler(limitRequestBody(registerABIHandler))
+    router.Methods("POST").Path("/sendRawTransaction").Handler(limitRequestBody(sendRawTransactionHandler))
+    router.Methods("GET", "POST").Path("/suggestFees").Handler(limitRequestBody(suggestFeesHandler))
+    router.Methods("GET").Path("/traceTransaction/{txHash}").Handler(traceTransactionHandler)
+    router.Methods("GET").Path("/getTokenBalance/{token}/{address}").Handler(getTokenBalanceHandler)
+    router.Methods("GET").PathPrefix("/admin/upstreams").Handler(getUpstreamStatusHandler)
 
     return router
+}
+
+// limitRequestBody caps the body next reads at MaxRequestBodySize.
+func limitRequestBody(next http.Handler) http.Handler {
+    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+        next.ServeHTTP(w, r)
+    })
+}
+
+// decodeJSONBody decodes the JSON body of r into v, telling a body cut short
+// by limitRequestBody from one that is not JSON.
+func decodeJSONBody(r *http.Request, v interface{}) error {
+    body, err := ioutil.ReadAll(r.Body)
+    if err != nil {
+        if int64(len(body)) >= MaxRequestBodySize {
+            return ErrRequestBodyTooLarge
+        }
+        return ErrInvalidRequestBody
+    }
+
+    err = json.Unmarshal(body, v)
+    if err != nil {
+        return ErrInvalidRequestBody
+    }
+    return nil
+}
+
+// MetricsHandler serves the expvar metrics as expvar.Handler does, but
+// without cmdline, whose flags may hold upstream credentials. It belongs on
+// an admin listener rather than the API router.
+func MetricsHandler() http.Handler {
+    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "application/json; charset=utf-8")
+        fmt.Fprintf(w, "{\n")
+        first := true
+        expvar.Do(func(kv expvar.KeyValue) {
+            if kv.Key == "cmdline" {
+                return
+            }
+            if !first {
+                fmt.Fprintf(w, ",\n")
+            }
+            first = false
+            fmt.Fprintf(w, "%q: %s", kv.Key, kv.Value)
+        })
+        fmt.Fprintf(w, "\n}\n")
+    })
 }
\ No newline at end of file
@@ -0,0 +1,45 @@
+package router
+
+import (
+    "context"
+    "encoding/json"
+    "net"
+    "time"
+)
+
+/* ----- IPC TRANSPORT ----- */
+
+// ipcConn speaks newline-delimited JSON-RPC over a Unix domain socket, the
+// framing geth uses on geth.ipc.
+type ipcConn struct {
+    conn net.Conn
+    decoder *json.Decoder
+}
+
+func (c ipcConn) ReadMessage() ([]byte, error) {
+    var msg json.RawMessage
+    err := c.decoder.Decode(&msg)
+    return msg, err
+}
+
+func (c ipcConn) WriteMessage(msg []byte) error {
+    c.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+    _, err := c.conn.Write(append(msg, '\n'))
+    return err
+}
+
+func (c ipcConn) Close() error {
+    return c.conn.Close()
+}
+
+// NewIPCRPCClient returns a client for the Unix socket at socketPath.
+func NewIPCRPCClient(socketPath string) *StreamRPCClient {
+    return newStreamRPCClient("ipc://" + socketPath, func(ctx context.Context) (streamConn, error) {
+        var dialer net.Dialer
+        conn, err := dialer.DialContext(ctx, "unix", socketPath)
+        if err != nil {
+            return nil, err
+        }
+        return ipcConn{conn, json.NewDecoder(conn)}, nil
+    })
+}
@@ -0,0 +1,196 @@
+package router
+
+import (
+    "context"
+    "expvar"
+    "io"
+    "sync"
+    "time"
+)
+
+var queueWaitMetrics = expvar.NewMap("upstream_queue_wait_ms")
+var queuedCallsMetrics = expvar.NewMap("upstream_queued_calls")
+
+// LimitConfig caps the load sent to one upstream. Zero values disable the
+// corresponding limit.
+type LimitConfig struct {
+    // RequestsPerSecond is the token bucket refill rate; every JSON-RPC
+    // request, batched or not, takes one token.
+    RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+    // Burst is the bucket size, at least 1. Defaults to RequestsPerSecond.
+    Burst int `json:"burst,omitempty"`
+    // MaxInFlight caps the calls (single or batch) sent concurrently.
+    MaxInFlight int `json:"maxInFlight,omitempty"`
+}
+
+func (c LimitConfig) isEmpty() bool {
+    return c.RequestsPerSecond <= 0 && c.MaxInFlight <= 0
+}
+
+/* ----- TOKEN BUCKET ----- */
+type tokenBucket struct {
+    rate float64
+    burst float64
+
+    mu sync.Mutex
+    tokens float64
+    last time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+    if burst < 1 {
+        burst = int(rate)
+        if burst < 1 {
+            burst = 1
+        }
+    }
+
+    return &tokenBucket{
+        rate: rate,
+        burst: float64(burst),
+        tokens: float64(burst),
+        last: time.Now(),
+    }
+}
+
+// wait blocks until n tokens are taken or ctx is done. Requests for more
+// tokens than the bucket holds are charged a full bucket.
+func (b *tokenBucket) wait(ctx context.Context, n int) error {
+    need := float64(n)
+    if need > b.burst {
+        need = b.burst
+    }
+
+    for {
+        b.mu.Lock()
+        now := time.Now()
+        b.tokens += now.Sub(b.last).Seconds() * b.rate
+        if b.tokens > b.burst {
+            b.tokens = b.burst
+        }
+        b.last = now
+
+        if b.tokens >= need {
+            b.tokens -= need
+            b.mu.Unlock()
+            return nil
+        }
+
+        delay := time.Duration((need - b.tokens) / b.rate * float64(time.Second))
+        b.mu.Unlock()
+
+        select {
+        case <-time.After(delay):
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+    }
+}
+
+/* ----- LIMITING CLIENT ----- */
+
+// LimitedRPCClient queues calls to an upstream behind a max-in-flight
+// semaphore and a token bucket. Queued calls give up when their context is
+// done; the time spent queueing is exported per upstream, by its redacted
+// url.
+type LimitedRPCClient struct {
+    Url string
+
+    name string
+    client RPCClient
+    bucket *tokenBucket
+    inFlight chan struct{}
+}
+
+func NewLimitedRPCClient(url string, client RPCClient, config LimitConfig) *LimitedRPCClient {
+    c := &LimitedRPCClient{
+        Url: url,
+        name: redactUrl(url),
+        client: client,
+    }
+
+    if config.RequestsPerSecond > 0 {
+        c.bucket = newTokenBucket(config.RequestsPerSecond, config.Burst)
+    }
+
+    if config.MaxInFlight > 0 {
+        c.inFlight = make(chan struct{}, config.MaxInFlight)
+    }
+
+    return c
+}
+
+func (c *LimitedRPCClient) Unwrap() RPCClient {
+    return c.client
+}
+
+func (c *LimitedRPCClient) Close() error {
+    if closer, ok := c.client.(io.Closer); ok {
+        return closer.Close()
+    }
+    return nil
+}
+
+func (c *LimitedRPCClient) Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    err := c.acquire(ctx, 1)
+    if err != nil {
+        return nil, err
+    }
+    defer c.release()
+
+    return c.client.Call(ctx, rpcReq)
+}
+
+func (c *LimitedRPCClient) CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error) {
+    err := c.acquire(ctx, len(rpcReqs))
+    if err != nil {
+        return nil, err
+    }
+    defer c.release()
+
+    return c.client.CallBatch(ctx, rpcReqs)
+}
+
+// acquire takes an in-flight slot, then the tokens, so a call giving up
+// while queued never spends tokens.
+func (c *LimitedRPCClient) acquire(ctx context.Context, requests int) error {
+    started := time.Now()
+
+    if c.inFlight != nil {
+        select {
+        case c.inFlight <- struct{}{}:
+        case <-ctx.Done():
+            c.recordWait(ctx, started)
+            return ctx.Err()
+        }
+    }
+
+    if c.bucket != nil {
+        err := c.bucket.wait(ctx, requests)
+        if err != nil {
+            c.release()
+            c.recordWait(ctx, started)
+            return err
+        }
+    }
+
+    c.recordWait(ctx, started)
+    return nil
+}
+
+func (c *LimitedRPCClient) release() {
+    if c.inFlight != nil {
+        <-c.inFlight
+    }
+}
+
+func (c *LimitedRPCClient) recordWait(ctx context.Context, started time.Time) {
+    wait := time.Since(started)
+    if wait < time.Millisecond {
+        return
+    }
+
+    logUpstream(ctx, "Call to upstream \"" + c.name + "\" queued for " + wait.String())
+    queuedCallsMetrics.Add(c.name, 1)
+    queueWaitMetrics.AddFloat(c.name, float64(wait) / float64(time.Millisecond))
+}
\ No newline at end of file
@@ -0,0 +1,307 @@
+package router
+
+import (
+    "context"
+    "encoding/base64"
+    "strconv"
+    "strings"
+)
+
+// DefaultLogsChunkSize is the number of blocks asked for in one eth_getLogs
+// call, before the node makes us shrink it.
+const DefaultLogsChunkSize int64 = 2000
+
+const DefaultLogsPageSize int = 1000
+const MaxLogsPageSize int = 10000
+
+// logsChunksPerPage bounds the eth_getLogs calls made for one page, so
+// sparse logs over a long range still come back in bounded time.
+const logsChunksPerPage int = 16
+
+// GetLogsRequest filters logs by emitting Addresses and by Topics, where
+// Topics[i] lists the alternatives for the i-th topic and an empty list
+// matches any. Logs come from BlockHash, or from FromBlock to ToBlock, both
+// latest when empty. Cursor continues a previous page and takes precedence
+// over the range; Limit is the page size.
+type GetLogsRequest struct {
+    Addresses []string
+    Topics [][]string
+    FromBlock string
+    ToBlock string
+    BlockHash string
+    Cursor string
+    Limit int
+}
+
+// LogsPage holds the logs of blocks FromBlock to ToBlock. NextCursor is set
+// while blocks of the requested range are left.
+type LogsPage struct {
+    Logs []Log `json:"logs"`
+    FromBlock string `json:"fromBlock,omitempty"`
+    ToBlock string `json:"toBlock,omitempty"`
+    NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// GetLogs returns one page of logs. The block range is split into chunks of
+// LogsChunkSize blocks; a chunk the node refuses for having too many results
+// or blocks is halved until it passes. A page ends at a chunk boundary once
+// it holds at least Limit logs, or at a block boundary to keep it within
+// MaxLogsPageSize logs.
+func (svc EthServiceImp) GetLogs(ctx context.Context, req GetLogsRequest) (interface{}, error) {
+    filter, err := newLogFilter(req)
+    if err != nil {
+        return nil, err
+    }
+
+    if req.BlockHash != "" {
+        return svc.getBlockLogs(ctx, filter, req.BlockHash)
+    }
+
+    from, to, err := svc.logsRange(ctx, req)
+    if err != nil {
+        return nil, err
+    }
+
+    limit := req.Limit
+    if limit <= 0 {
+        limit = DefaultLogsPageSize
+    }
+    if limit > MaxLogsPageSize {
+        limit = MaxLogsPageSize
+    }
+
+    chunkSize := svc.LogsChunkSize
+    if chunkSize <= 0 {
+        chunkSize = DefaultLogsChunkSize
+    }
+
+    page := LogsPage{Logs: []Log{}, FromBlock: encodeHexInt(from)}
+    next := from
+    chunks := 0
+    for next <= to {
+        if len(page.Logs) >= limit || chunks == logsChunksPerPage {
+            page.NextCursor = encodeLogsCursor(next, to)
+            break
+        }
+
+        end := next + chunkSize - 1
+        if end > to {
+            end = to
+        }
+
+        filter.FromBlock = encodeHexInt(next)
+        filter.ToBlock = encodeHexInt(end)
+
+        rpcReq := EthRPCRequest{}
+        rpcReq.constructGetLogsRequest(filter)
+
+        var logs []Log
+        err = callRPC(ctx, svc.client, rpcReq, &logs)
+        if isTooManyResults(err) && end > next {
+            chunkSize = (end - next + 1) / 2
+            logUpstream(ctx, "Shrinking eth_getLogs chunk to " + strconv.FormatInt(chunkSize, 10) + " blocks after: " + err.Error())
+            continue
+        }
+        if err != nil {
+            return nil, err
+        }
+
+        page.Logs = append(page.Logs, logs...)
+        page.ToBlock = filter.ToBlock
+        next = end + 1
+        chunks++
+
+        if len(page.Logs) > MaxLogsPageSize {
+            cut, cutBlock, ok := logsPageCut(page.Logs)
+            if ok {
+                page.Logs = page.Logs[:cut]
+                page.ToBlock = encodeHexInt(cutBlock - 1)
+                page.NextCursor = encodeLogsCursor(cutBlock, to)
+                break
+            }
+        }
+    }
+
+    return page, nil
+}
+
+// logsPageCut finds where to cut logs down to MaxLogsPageSize without
+// splitting a block, as cursors point at blocks. It returns the index of
+// the first log left out and its block number. A page keeps its first block
+// whole even when that block alone holds more logs.
+func logsPageCut(logs []Log) (int, int64, bool) {
+    cut := MaxLogsPageSize
+    for cut > 0 && logs[cut - 1].BlockNumber == logs[cut].BlockNumber {
+        cut--
+    }
+    if cut == 0 {
+        for cut < len(logs) && logs[cut].BlockNumber == logs[0].BlockNumber {
+            cut++
+        }
+        if cut == len(logs) {
+            return 0, 0, false
+        }
+    }
+
+    cutBlock, err := decodeHexInt(logs[cut].BlockNumber)
+    if err != nil {
+        return 0, 0, false
+    }
+    return cut, cutBlock, true
+}
+
+func (svc EthServiceImp) getBlockLogs(ctx context.Context, filter LogFilter, blockHash string) (interface{}, error) {
+    if !isHexHash(blockHash) {
+        return nil, ErrInvalidBlockHash
+    }
+    filter.BlockHash = blockHash
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructGetLogsRequest(filter)
+
+    page := LogsPage{Logs: []Log{}}
+    err := callRPC(ctx, svc.client, rpcReq, &page.Logs)
+    if err != nil {
+        return nil, err
+    }
+
+    return page, nil
+}
+
+// logsRange resolves the block range of req, or of its cursor, to numbers.
+func (svc EthServiceImp) logsRange(ctx context.Context, req GetLogsRequest) (int64, int64, error) {
+    if req.Cursor != "" {
+        return decodeLogsCursor(req.Cursor)
+    }
+
+    from, err := svc.resolveBlockNumber(ctx, req.FromBlock)
+    if err != nil {
+        return 0, 0, err
+    }
+
+    to, err := svc.resolveBlockNumber(ctx, req.ToBlock)
+    if err != nil {
+        return 0, 0, err
+    }
+
+    if from > to {
+        return 0, 0, ErrInvalidBlockRange
+    }
+
+    return from, to, nil
+}
+
+// resolveBlockNumber turns a block number or tag into a number, asking the
+// node for the block a tag stands for. Empty means latest.
+func (svc EthServiceImp) resolveBlockNumber(ctx context.Context, block string) (int64, error) {
+    if block == "" {
+        block = "latest"
+    }
+
+    block, err := normalizeBlockNumber(block)
+    if err != nil {
+        return 0, err
+    }
+
+    if !blockTags[block] {
+        return decodeHexInt(block)
+    }
+    if block == "earliest" {
+        return 0, nil
+    }
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructGetBlockByNumberRequest(block, false)
+
+    var header rpcBlock
+    err = callRPC(ctx, svc.client, rpcReq, &header)
+    if err != nil {
+        return 0, err
+    }
+
+    // Nodes leave the number of the pending block null when they do not
+    // build one.
+    if header.Number == "" {
+        return 0, ErrUnresolvedBlockTag
+    }
+    return decodeHexInt(header.Number)
+}
+
+// newLogFilter validates the addresses and topics of req.
+func newLogFilter(req GetLogsRequest) (LogFilter, error) {
+    filter := LogFilter{}
+    for _, address := range req.Addresses {
+        if !isHexAddress(address) {
+            return filter, ErrInvalidAddress
+        }
+        filter.Address = append(filter.Address, address)
+    }
+
+    if len(req.Topics) > 4 {
+        return filter, ErrInvalidTopic
+    }
+
+    for _, alternatives := range req.Topics {
+        for _, topic := range alternatives {
+            if !isHexHash(topic) {
+                return filter, ErrInvalidTopic
+            }
+        }
+
+        switch len(alternatives) {
+        case 0:
+            filter.Topics = append(filter.Topics, nil)
+        case 1:
+            filter.Topics = append(filter.Topics, alternatives[0])
+        default:
+            filter.Topics = append(filter.Topics, alternatives)
+        }
+    }
+
+    return filter, nil
+}
+
+// isTooManyResults tells whether the node refused an eth_getLogs call for
+// matching too many logs or spanning too many blocks. Nodes report both
+// with -32005, the code they also use for rate limiting.
+func isTooManyResults(err error) bool {
+    rpcErr, ok := err.(*RPCError)
+    if !ok || rpcErr.Code != RPCErrLimitExceeded {
+        return false
+    }
+
+    message := strings.ToLower(rpcErr.Message)
+    return strings.Contains(message, "more than") ||
+        strings.Contains(message, "block range") ||
+        strings.Contains(message, "response size")
+}
+
+// Cursors carry the next block and the end of the range, so later pages
+// keep to the range resolved for the first one.
+func encodeLogsCursor(next int64, to int64) string {
+    return base64.RawURLEncoding.EncodeToString([]byte(encodeHexInt(next) + ":" + encodeHexInt(to)))
+}
+
+func decodeLogsCursor(cursor string) (int64, int64, error) {
+    data, err := base64.RawURLEncoding.DecodeString(cursor)
+    if err != nil {
+        return 0, 0, ErrInvalidCursor
+    }
+
+    parts := strings.Split(string(data), ":")
+    if len(parts) != 2 {
+        return 0, 0, ErrInvalidCursor
+    }
+
+    next, err := decodeHexInt(parts[0])
+    if err != nil {
+        return 0, 0, ErrInvalidCursor
+    }
+
+    to, err := decodeHexInt(parts[1])
+    if err != nil || next < 0 || next > to {
+        return 0, 0, ErrInvalidCursor
+    }
+
+    return next, to, nil
+}
\ No newline at end of file
@@ -0,0 +1,197 @@
+package router
+
+import (
+    "context"
+    "log"
+    "sync"
+    "time"
+
+    "github.com/go-kit/kit/endpoint"
+)
+
+// applyMiddlewares wraps e in middlewares, the first one outermost.
+func applyMiddlewares(e endpoint.Endpoint, middlewares []endpoint.Middleware) endpoint.Endpoint {
+    if len(middlewares) == 0 {
+        return e
+    }
+
+    return endpoint.Chain(middlewares[0], middlewares[1:]...)(e)
+}
+
+/* ----- CIRCUIT BREAKER ----- */
+type CircuitState int
+
+const (
+    CircuitClosed CircuitState = iota
+    CircuitOpen
+    CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+    switch s {
+    case CircuitOpen:
+        return "open"
+    case CircuitHalfOpen:
+        return "half-open"
+    }
+    return "closed"
+}
+
+type CircuitBreakerConfig struct {
+    // FailureThreshold consecutive upstream failures open the circuit.
+    FailureThreshold int
+    // OpenTimeout is how long the circuit stays open before letting trial
+    // requests through.
+    OpenTimeout time.Duration
+    // HalfOpenMaxRequests trial requests run concurrently while half-open;
+    // that many successes in a row close the circuit again.
+    HalfOpenMaxRequests int
+}
+
+var DefaultCircuitBreakerConfig = CircuitBreakerConfig{
+    FailureThreshold: 5,
+    OpenTimeout: 30 * time.Second,
+    HalfOpenMaxRequests: 1,
+}
+
+// CircuitBreaker stops calling the node while it is failing, answering with
+// ErrUpstreamUnavailable instead. Only errors that mean the node could not be
+// reached count as failures; node-level JSON-RPC errors do not. Only calls
+// that succeed count as successes: other errors, such as invalid requests
+// or missing blocks, say nothing about the node either way.
+type CircuitBreaker struct {
+    config CircuitBreakerConfig
+
+    mu sync.Mutex
+    state CircuitState
+    failures int
+    openedAt time.Time
+    // round counts the times the circuit went half-open, so trials of an
+    // earlier round are not taken for trials of the current one.
+    round int
+    halfOpenInFlight int
+    halfOpenSuccesses int
+}
+
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+    return &CircuitBreaker{config: config}
+}
+
+func (cb *CircuitBreaker) State() CircuitState {
+    cb.mu.Lock()
+    defer cb.mu.Unlock()
+
+    return cb.state
+}
+
+func (cb *CircuitBreaker) Middleware() endpoint.Middleware {
+    return func(next endpoint.Endpoint) endpoint.Endpoint {
+        return func(ctx context.Context, request interface{}) (interface{}, error) {
+            trial, ok := cb.allow()
+            if !ok {
+                return nil, ErrUpstreamUnavailable
+            }
+
+            response, err := next(ctx, request)
+            cb.record(ctx, err, trial)
+
+            return response, err
+        }
+    }
+}
+
+// allow tells whether a request may call the node and, for trials while
+// half-open, the round it was let through in. Other requests get round 0.
+func (cb *CircuitBreaker) allow() (int, bool) {
+    cb.mu.Lock()
+    defer cb.mu.Unlock()
+
+    switch cb.state {
+    case CircuitOpen:
+        if time.Since(cb.openedAt) < cb.config.OpenTimeout {
+            return 0, false
+        }
+        cb.setState(CircuitHalfOpen)
+        cb.round++
+        cb.halfOpenInFlight = 0
+        cb.halfOpenSuccesses = 0
+        fallthrough
+    case CircuitHalfOpen:
+        if cb.halfOpenInFlight >= cb.config.HalfOpenMaxRequests {
+            return 0, false
+        }
+        cb.halfOpenInFlight++
+        return cb.round, true
+    }
+
+    return 0, true
+}
+
+// record counts the outcome of a request let through in trial round trial.
+// While half-open only the trials of the current round count; requests let
+// through earlier say nothing about the node since it went half-open.
+func (cb *CircuitBreaker) record(ctx context.Context, err error, trial int) {
+    failed := isUpstreamFailure(ctx, err)
+
+    cb.mu.Lock()
+    defer cb.mu.Unlock()
+
+    switch cb.state {
+    case CircuitClosed:
+        if err == nil {
+            cb.failures = 0
+            return
+        }
+        if !failed {
+            return
+        }
+
+        cb.failures++
+        if cb.failures >= cb.config.FailureThreshold {
+            cb.open()
+        }
+    case CircuitHalfOpen:
+        if trial != cb.round {
+            return
+        }
+
+        cb.halfOpenInFlight--
+        if failed {
+            cb.open()
+            return
+        }
+        if err != nil {
+            // The trial did not show the node is back; let another one run.
+            return
+        }
+
+        cb.halfOpenSuccesses++
+        if cb.halfOpenSuccesses >= cb.config.HalfOpenMaxRequests {
+            cb.failures = 0
+            cb.setState(CircuitClosed)
+        }
+    }
+}
+
+func (cb *CircuitBreaker) open() {
+    cb.openedAt = time.Now()
+    cb.setState(CircuitOpen)
+}
+
+func (cb *CircuitBreaker) setState(state CircuitState) {
+    if cb.state != state {
+        log.Println("Upstream circuit breaker " + cb.state.String() + " -> " + state.String())
+    }
+    cb.state = state
+}
+
+// isUpstreamFailure tells whether err means the node could not be reached.
+// Deadlines count only when they are not the caller's own: a client that
+// gives up early says nothing about the node.
+func isUpstreamFailure(ctx context.Context, err error) bool {
+    if err == context.DeadlineExceeded {
+        return ctx.Err() == nil
+    }
+
+    return isConnectionError(err)
+}
\ No newline at end of file
@@ -0,0 +1,64 @@
+package router
+
+import (
+    "context"
+    "testing"
+    "time"
+)
+
+// heldCall runs a request through the breaker that finishes with err once
+// released, and returns the channel releasing it and the one it answers on.
+func heldCall(breaker *CircuitBreaker, err error) (chan struct{}, chan error) {
+    release := make(chan struct{})
+    done := make(chan error, 1)
+    call := breaker.Middleware()(func(ctx context.Context, request interface{}) (interface{}, error) {
+        <-release
+        return nil, err
+    })
+
+    go func() {
+        _, err := call(context.Background(), nil)
+        done <- err
+    }()
+    return release, done
+}
+
+func TestCircuitBreakerCountsOnlyTrialsOfTheCurrentRound(t *testing.T) {
+    breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond, HalfOpenMaxRequests: 1})
+
+    releaseEarly, early := heldCall(breaker, nil)
+    releaseStale, stale := heldCall(breaker, ErrConnectingToGeth)
+    time.Sleep(10 * time.Millisecond)
+
+    releaseFailure, failure := heldCall(breaker, ErrConnectingToGeth)
+    close(releaseFailure)
+    <-failure
+    if breaker.State() != CircuitOpen {
+        t.Fatalf("got %s, want open after a failure", breaker.State())
+    }
+
+    time.Sleep(20 * time.Millisecond)
+    releaseTrial, trial := heldCall(breaker, nil)
+    time.Sleep(10 * time.Millisecond)
+
+    // Requests let through while closed finish during the trial.
+    close(releaseEarly)
+    <-early
+    close(releaseStale)
+    <-stale
+    if breaker.State() != CircuitHalfOpen {
+        t.Fatalf("got %s, want requests from before the trial to be ignored", breaker.State())
+    }
+
+    releaseSecond, second := heldCall(breaker, nil)
+    close(releaseSecond)
+    if err := <-second; err != ErrUpstreamUnavailable {
+        t.Errorf("second trial got %v, want ErrUpstreamUnavailable while the first runs", err)
+    }
+
+    close(releaseTrial)
+    <-trial
+    if breaker.State() != CircuitClosed {
+        t.Errorf("got %s, want closed after the trial succeeded", breaker.State())
+    }
+}
\ No newline at end of file
@@ -0,0 +1,525 @@
+package router
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "io"
+    "log"
+    "net/http"
+    "sort"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+)
+
+const defaultHealthCheckInterval time.Duration = 10 * time.Second
+const defaultHealthCheckTimeout time.Duration = 5 * time.Second
+const DefaultMaxBlockLag int64 = 5
+
+// Score penalties, in milliseconds of equivalent latency.
+const blockLagPenalty float64 = 100
+const syncingPenalty float64 = 10000
+
+// RPCStatusReporter is implemented by upstream clients that can describe
+// the state of the nodes behind them.
+type RPCStatusReporter interface {
+    UpstreamStatus() PoolStatus
+}
+
+// UpstreamStatus is the health of one upstream. Url is redacted to the
+// scheme and host of the upstream.
+type UpstreamStatus struct {
+    Url string `json:"url"`
+    Healthy bool `json:"healthy"`
+    Syncing bool `json:"syncing"`
+    BlockNumber int64 `json:"blockNumber"`
+    Lagging bool `json:"lagging"`
+    LatencyMs float64 `json:"latencyMs"`
+    Score float64 `json:"score"`
+    LastError string `json:"lastError,omitempty"`
+    LastChecked time.Time `json:"lastChecked"`
+    Served int64 `json:"served"`
+    Failures int64 `json:"failures"`
+}
+
+type PoolStatus struct {
+    Upstreams []UpstreamStatus `json:"upstreams"`
+    LastServedBy string `json:"lastServedBy"`
+    LastServedAt time.Time `json:"lastServedAt"`
+}
+
+type poolUpstream struct {
+    client RPCClient
+    status UpstreamStatus
+}
+
+type newHead struct {
+    Number string `json:"number"`
+}
+
+// blockLookupMethods return null for blocks and transactions the node has
+// not seen yet. Calls to them are retried on a node further ahead.
+var blockLookupMethods = map[string]bool{
+    "eth_getBlockByHash": true,
+    "eth_getBlockByNumber": true,
+    "eth_getBlockTransactionCountByHash": true,
+    "eth_getBlockTransactionCountByNumber": true,
+    "eth_getTransactionByHash": true,
+    "eth_getTransactionByBlockHashAndIndex": true,
+    "eth_getTransactionByBlockNumberAndIndex": true,
+    "eth_getTransactionReceipt": true,
+}
+
+// errBlockNotSeen marks a null lookup result from an upstream that may not
+// have the block yet.
+var errBlockNotSeen = errors.New("block not seen by upstream")
+
+/* ----- UPSTREAM POOL ----- */
+
+// PoolRPCClient spreads calls over several upstream nodes. A background
+// health checker scores every node from eth_syncing, eth_blockNumber and
+// latency, and follows node heads through newHeads where the transport
+// supports it. Nodes more than MaxBlockLag blocks behind the highest head are
+// only used when no other node is healthy. A node is not healthy until its
+// first health check succeeds.
+//
+// Each call goes to the best healthy node and fails over to the next one on
+// connection errors. A null result from a block or transaction lookup is
+// retried on nodes with a higher head. Methods listed in QuorumMethods, or calls
+// whose context carries a Quorum, are sent to several nodes at once instead.
+type PoolRPCClient struct {
+    HealthCheckInterval time.Duration
+    HealthCheckTimeout time.Duration
+    QuorumMethods map[string]Quorum
+    MaxBlockLag int64
+
+    mu sync.Mutex
+    upstreams []*poolUpstream
+    highestBlock int64
+    lastServedBy string
+    lastServedAt time.Time
+
+    stop chan struct{}
+    closeOnce sync.Once
+}
+
+func NewPoolRPCClient(configs []RPCClientConfig) (*PoolRPCClient, error) {
+    if len(configs) == 0 {
+        return nil, ErrInvalidUpstreamUrl
+    }
+
+    pool := &PoolRPCClient{
+        HealthCheckInterval: defaultHealthCheckInterval,
+        HealthCheckTimeout: defaultHealthCheckTimeout,
+        QuorumMethods: map[string]Quorum{},
+        MaxBlockLag: DefaultMaxBlockLag,
+        stop: make(chan struct{}),
+    }
+
+    for _, config := range configs {
+        client, err := NewRPCClient(config)
+        if err != nil {
+            return nil, err
+        }
+
+        pool.upstreams = append(pool.upstreams, &poolUpstream{
+            client: client,
+            status: UpstreamStatus{Url: redactUrl(config.Url)},
+        })
+    }
+
+    return pool, nil
+}
+
+// Start runs the health checker and head subscriptions until Close is
+// called. The first round of checks runs before Start returns, so calls are
+// never routed on heads nobody has checked yet.
+func (p *PoolRPCClient) Start() {
+    p.checkHealth()
+
+    for _, upstream := range p.upstreams {
+        if subscriber, ok := findSubscriber(upstream.client); ok {
+            go p.followHeads(upstream, subscriber)
+        }
+    }
+
+    go func() {
+        for {
+            select {
+            case <-time.After(p.HealthCheckInterval):
+            case <-p.stop:
+                return
+            }
+
+            p.checkHealth()
+        }
+    }()
+}
+
+// Close stops the health checker and closes the upstreams. Calling it again
+// does nothing.
+func (p *PoolRPCClient) Close() error {
+    p.closeOnce.Do(func() {
+        close(p.stop)
+
+        for _, upstream := range p.upstreams {
+            if closer, ok := upstream.client.(io.Closer); ok {
+                closer.Close()
+            }
+        }
+    })
+    return nil
+}
+
+func (p *PoolRPCClient) Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    if quorum := p.quorumFor(ctx, rpcReq.Method); quorum.enabled() {
+        resps, err := p.withQuorum(ctx, rpcReq.Method, quorum, func(client RPCClient) ([][]byte, error) {
+            resp, err := client.Call(ctx, rpcReq)
+            return [][]byte{resp}, err
+        })
+        if err != nil {
+            return nil, err
+        }
+        return resps[0], nil
+    }
+
+    var resp []byte
+    err := p.withFailover(ctx, func(upstream *poolUpstream) error {
+        upstreamResp, err := upstream.client.Call(ctx, rpcReq)
+        if err != nil {
+            return err
+        }
+
+        resp = upstreamResp
+        if p.blockNotSeen(upstream, rpcReq, upstreamResp) {
+            return errBlockNotSeen
+        }
+        return nil
+    })
+
+    return resp, err
+}
+
+func (p *PoolRPCClient) CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error) {
+    methods := make([]string, len(rpcReqs))
+    for i, rpcReq := range rpcReqs {
+        methods[i] = rpcReq.Method
+    }
+
+    if quorum := p.quorumFor(ctx, methods...); quorum.enabled() {
+        return p.withQuorum(ctx, "batch", quorum, func(client RPCClient) ([][]byte, error) {
+            return client.CallBatch(ctx, rpcReqs)
+        })
+    }
+
+    var resps [][]byte
+    err := p.withFailover(ctx, func(upstream *poolUpstream) error {
+        upstreamResps, err := upstream.client.CallBatch(ctx, rpcReqs)
+        if err != nil {
+            return err
+        }
+
+        resps = upstreamResps
+        for i, rpcReq := range rpcReqs {
+            if p.blockNotSeen(upstream, rpcReq, upstreamResps[i]) {
+                return errBlockNotSeen
+            }
+        }
+        return nil
+    })
+
+    return resps, err
+}
+
+func (p *PoolRPCClient) UpstreamStatus() PoolStatus {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    status := PoolStatus{
+        Upstreams: make([]UpstreamStatus, len(p.upstreams)),
+        LastServedBy: p.lastServedBy,
+        LastServedAt: p.lastServedAt,
+    }
+    for i, upstream := range p.upstreams {
+        status.Upstreams[i] = upstream.status
+    }
+
+    return status
+}
+
+// withFailover runs call against upstreams in order of preference until one
+// of them does not fail with a connection error. When call reports
+// errBlockNotSeen only upstreams with a higher head are tried next; if none
+// has the block the null answer stands.
+func (p *PoolRPCClient) withFailover(ctx context.Context, call func(*poolUpstream) error) error {
+    err := ErrConnectingToGeth
+    notSeenAt := int64(-1)
+    for _, upstream := range p.candidates() {
+        head := p.headOf(upstream)
+        if notSeenAt >= 0 && head <= notSeenAt {
+            continue
+        }
+
+        err = call(upstream)
+        if err == errBlockNotSeen {
+            p.markServed(upstream)
+            logUpstream(ctx, "Upstream \"" + upstream.status.Url + "\" at block " + strconv.FormatInt(head, 10) + " returned null, trying nodes further ahead")
+            notSeenAt = head
+            continue
+        }
+
+        if !isConnectionError(err) {
+            p.markServed(upstream)
+            return err
+        }
+
+        p.markFailed(ctx, upstream, err)
+
+        if ctx.Err() != nil {
+            return ctx.Err()
+        }
+    }
+
+    if notSeenAt >= 0 {
+        return nil
+    }
+    return err
+}
+
+// blockNotSeen tells whether resp is a null lookup result for a block that
+// may be newer than upstream's head. Blocks by hash can be newer than any
+// head; blocks by number only when above it.
+func (p *PoolRPCClient) blockNotSeen(upstream *poolUpstream, rpcReq EthRPCRequest, resp []byte) bool {
+    if !blockLookupMethods[rpcReq.Method] {
+        return false
+    }
+
+    var result json.RawMessage
+    if decodeRPCResult(resp, &result) != ErrNullResult {
+        return false
+    }
+
+    if strings.Contains(rpcReq.Method, "ByNumber") && len(rpcReq.Params) > 0 {
+        if block, ok := rpcReq.Params[0].(string); ok {
+            blockNumber, err := decodeHexInt(block)
+            if err == nil {
+                return blockNumber > p.headOf(upstream)
+            }
+        }
+    }
+
+    return true
+}
+
+func (p *PoolRPCClient) headOf(upstream *poolUpstream) int64 {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    return upstream.status.BlockNumber
+}
+
+func (p *PoolRPCClient) markServed(upstream *poolUpstream) {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    upstream.status.Served++
+    p.lastServedBy = upstream.status.Url
+    p.lastServedAt = time.Now()
+}
+
+// markFailed takes upstream out of rotation until the next health check
+// finds it healthy again.
+func (p *PoolRPCClient) markFailed(ctx context.Context, upstream *poolUpstream, err error) {
+    logUpstream(ctx, "Upstream \"" + upstream.status.Url + "\" failed, failing over: " + err.Error())
+
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    upstream.status.Healthy = false
+    upstream.status.Failures++
+    upstream.status.LastError = err.Error()
+}
+
+// candidates returns healthy upstreams best score first, leaving out lagging
+// ones unless they are all that is left. When none are healthy every
+// upstream is tried in configured order.
+func (p *PoolRPCClient) candidates() []*poolUpstream {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    healthy := []*poolUpstream{}
+    lagging := []*poolUpstream{}
+    for _, upstream := range p.upstreams {
+        if !upstream.status.Healthy {
+            continue
+        }
+
+        if upstream.status.Lagging {
+            lagging = append(lagging, upstream)
+        } else {
+            healthy = append(healthy, upstream)
+        }
+    }
+
+    if len(healthy) == 0 {
+        healthy = lagging
+    }
+
+    if len(healthy) == 0 {
+        return append([]*poolUpstream{}, p.upstreams...)
+    }
+
+    sort.SliceStable(healthy, func(i, j int) bool {
+        return healthy[i].status.Score < healthy[j].status.Score
+    })
+    return healthy
+}
+
+func (p *PoolRPCClient) checkHealth() {
+    var wg sync.WaitGroup
+    for _, upstream := range p.upstreams {
+        wg.Add(1)
+        go func(upstream *poolUpstream) {
+            defer wg.Done()
+            p.checkUpstream(upstream)
+        }(upstream)
+    }
+    wg.Wait()
+
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    p.rescore()
+}
+
+// rescore recomputes the highest head, lag and score of every upstream. The
+// caller holds p.mu.
+func (p *PoolRPCClient) rescore() {
+    p.highestBlock = 0
+    for _, upstream := range p.upstreams {
+        if upstream.status.Healthy && upstream.status.BlockNumber > p.highestBlock {
+            p.highestBlock = upstream.status.BlockNumber
+        }
+    }
+
+    for _, upstream := range p.upstreams {
+        lag := p.highestBlock - upstream.status.BlockNumber
+        wasLagging := upstream.status.Lagging
+        upstream.status.Lagging = lag > p.MaxBlockLag
+        if upstream.status.Lagging != wasLagging {
+            log.Println("Upstream \"" + upstream.status.Url + "\" is " + strconv.FormatInt(lag, 10) + " blocks behind, lagging: " + strconv.FormatBool(upstream.status.Lagging))
+        }
+
+        score := upstream.status.LatencyMs
+        score += float64(lag) * blockLagPenalty
+        if upstream.status.Syncing {
+            score += syncingPenalty
+        }
+        upstream.status.Score = score
+    }
+}
+
+// followHeads keeps the head of upstream current from newHeads
+// notifications between health checks.
+func (p *PoolRPCClient) followHeads(upstream *poolUpstream, subscriber RPCSubscriber) {
+    ctx, cancel := context.WithTimeout(context.Background(), p.HealthCheckTimeout)
+    sub, err := subscriber.Subscribe(ctx, "newHeads", nil)
+    cancel()
+    if err != nil {
+        log.Println("Following heads of upstream \"" + upstream.status.Url + "\" failed, relying on health checks: " + err.Error())
+        return
+    }
+
+    for {
+        select {
+        case notification, ok := <-sub.Notifications:
+            if !ok {
+                return
+            }
+
+            var head newHead
+            if json.Unmarshal(notification, &head) != nil {
+                continue
+            }
+
+            blockNumber, err := decodeHexInt(head.Number)
+            if err != nil {
+                continue
+            }
+
+            p.mu.Lock()
+            upstream.status.BlockNumber = blockNumber
+            p.rescore()
+            p.mu.Unlock()
+        case <-p.stop:
+            subscriber.Unsubscribe(context.Background(), sub)
+            return
+        }
+    }
+}
+
+func (p *PoolRPCClient) checkUpstream(upstream *poolUpstream) {
+    ctx, cancel := context.WithTimeout(context.Background(), p.HealthCheckTimeout)
+    defer cancel()
+
+    syncing, blockNumber, latency, err := probeUpstream(ctx, upstream.client)
+
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    upstream.status.LastChecked = time.Now()
+    if err != nil {
+        log.Println("Health check of upstream \"" + upstream.status.Url + "\" failed: " + err.Error())
+        upstream.status.Healthy = false
+        upstream.status.LastError = err.Error()
+        return
+    }
+
+    upstream.status.Healthy = true
+    upstream.status.Syncing = syncing
+    upstream.status.BlockNumber = blockNumber
+    upstream.status.LatencyMs = float64(latency) / float64(time.Millisecond)
+    upstream.status.LastError = ""
+}
+
+func probeUpstream(ctx context.Context, client RPCClient) (bool, int64, time.Duration, error) {
+    rpcReq := EthRPCRequest{}
+
+    rpcReq.constructGetSyncingRequest()
+    var syncingResult json.RawMessage
+    err := callRPC(ctx, client, rpcReq, &syncingResult)
+    if err != nil {
+        return false, 0, 0, err
+    }
+    syncing := string(syncingResult) != "false"
+
+    rpcReq.constructGetBlockNumberRequest()
+    started := time.Now()
+    var blockNumberHex string
+    err = callRPC(ctx, client, rpcReq, &blockNumberHex)
+    if err != nil {
+        return false, 0, 0, err
+    }
+    latency := time.Since(started)
+
+    blockNumber, err := decodeHexInt(blockNumberHex)
+    if err != nil {
+        return false, 0, 0, err
+    }
+
+    return syncing, blockNumber, latency, nil
+}
+
+func isConnectionError(err error) bool {
+    if statusErr, ok := err.(*HTTPStatusError); ok {
+        switch statusErr.StatusCode {
+        case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+            return true
+        }
+        return false
+    }
+
+    return err == ErrConnectingToGeth || err == ErrReadingGethResponse
+}
\ No newline at end of file
@@ -0,0 +1,268 @@
+package router
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "net/http"
+    "strconv"
+    "strings"
+    "sync"
+
+    "google.golang.org/grpc/metadata"
+)
+
+const QuorumHeader string = "X-Quorum"
+const quorumMetadataKey string = "x-quorum"
+
+type quorumContextKey struct{}
+
+// Quorum asks Of upstreams and only accepts a result at least Required of
+// them agree on. Upstreams that cannot be reached are replaced by spare ones
+// while any are left.
+type Quorum struct {
+    Required int
+    Of int
+}
+
+func (q Quorum) enabled() bool {
+    return q.Required > 1
+}
+
+func (q Quorum) String() string {
+    return strconv.Itoa(q.Required) + "/" + strconv.Itoa(q.Of)
+}
+
+// ParseQuorum parses "N/M", or "N" for N-of-N.
+func ParseQuorum(value string) (Quorum, error) {
+    parts := strings.SplitN(strings.TrimSpace(value), "/", 2)
+
+    required, err := strconv.Atoi(parts[0])
+    if err != nil {
+        return Quorum{}, ErrInvalidQuorum
+    }
+
+    of := required
+    if len(parts) == 2 {
+        of, err = strconv.Atoi(parts[1])
+        if err != nil {
+            return Quorum{}, ErrInvalidQuorum
+        }
+    }
+
+    if required < 1 || of < required {
+        return Quorum{}, ErrInvalidQuorum
+    }
+
+    return Quorum{Required: required, Of: of}, nil
+}
+
+// WithQuorum requests a quorum read for every upstream call made with ctx.
+// It can raise the quorum configured for a method in the pool but not
+// lower it.
+func WithQuorum(ctx context.Context, quorum Quorum) context.Context {
+    return context.WithValue(ctx, quorumContextKey{}, quorum)
+}
+
+func QuorumFromContext(ctx context.Context) (Quorum, bool) {
+    quorum, ok := ctx.Value(quorumContextKey{}).(Quorum)
+    return quorum, ok
+}
+
+func withQuorumValue(ctx context.Context, value string) context.Context {
+    if value == "" {
+        return ctx
+    }
+
+    quorum, err := ParseQuorum(value)
+    if err != nil {
+        logUpstream(ctx, "Ignoring invalid quorum \"" + value + "\"")
+        return ctx
+    }
+
+    return WithQuorum(ctx, quorum)
+}
+
+// quorumFromHTTP is a go-kit ServerBefore hook reading the X-Quorum header.
+func quorumFromHTTP(ctx context.Context, r *http.Request) context.Context {
+    return withQuorumValue(ctx, r.Header.Get(QuorumHeader))
+}
+
+// quorumFromGRPC is a go-kit ServerBefore hook reading x-quorum metadata.
+func quorumFromGRPC(ctx context.Context, md metadata.MD) context.Context {
+    if values := md.Get(quorumMetadataKey); len(values) > 0 {
+        return withQuorumValue(ctx, values[0])
+    }
+    return ctx
+}
+
+/* ----- QUORUM READS ----- */
+type quorumVote struct {
+    upstream *poolUpstream
+    resps [][]byte
+    err error
+    key string
+}
+
+// quorumFor returns the quorum for a call of methods: the strictest one
+// configured for any of the methods, raised to the one in ctx. No more
+// upstreams are asked than the pool has.
+func (p *PoolRPCClient) quorumFor(ctx context.Context, methods ...string) Quorum {
+    quorum := Quorum{}
+    for _, method := range methods {
+        if methodQuorum, ok := p.QuorumMethods[method]; ok && methodQuorum.Required > quorum.Required {
+            quorum = methodQuorum
+        }
+    }
+
+    if requested, ok := QuorumFromContext(ctx); ok {
+        if requested.Required > quorum.Required {
+            quorum.Required = requested.Required
+        }
+        if requested.Of > quorum.Of {
+            quorum.Of = requested.Of
+        }
+    }
+
+    if quorum.Of > len(p.upstreams) {
+        quorum.Of = len(p.upstreams)
+    }
+    return quorum
+}
+
+// withQuorum runs call against quorum.Of upstreams concurrently and returns
+// the responses at least quorum.Required of them agree on. Responses are
+// compared by their decoded result and error, element by element for
+// batches.
+func (p *PoolRPCClient) withQuorum(ctx context.Context, method string, quorum Quorum, call func(RPCClient) ([][]byte, error)) ([][]byte, error) {
+    var mu sync.Mutex
+    spares := p.quorumCandidates()
+    if len(spares) < quorum.Required {
+        return nil, ErrQuorumUnavailable
+    }
+
+    votes := []quorumVote{}
+    lastErr := error(ErrQuorumUnavailable)
+
+    slots := quorum.Of
+    if slots > len(spares) {
+        slots = len(spares)
+    }
+
+    var wg sync.WaitGroup
+    for slot := 0; slot < slots; slot++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+
+            for {
+                mu.Lock()
+                if len(spares) == 0 {
+                    mu.Unlock()
+                    return
+                }
+                upstream := spares[0]
+                spares = spares[1:]
+                mu.Unlock()
+
+                resps, err := call(upstream.client)
+                if isConnectionError(err) {
+                    p.markFailed(ctx, upstream, err)
+                    mu.Lock()
+                    lastErr = err
+                    mu.Unlock()
+                    continue
+                }
+                p.markServed(upstream)
+
+                mu.Lock()
+                votes = append(votes, quorumVote{upstream, resps, err, quorumKey(resps, err)})
+                mu.Unlock()
+                return
+            }
+        }()
+    }
+    wg.Wait()
+
+    if ctx.Err() != nil {
+        return nil, ctx.Err()
+    }
+
+    counts := map[string]int{}
+    var winner *quorumVote
+    for i := range votes {
+        counts[votes[i].key]++
+        if winner == nil || counts[votes[i].key] > counts[winner.key] {
+            winner = &votes[i]
+        }
+    }
+
+    if winner == nil || counts[winner.key] < quorum.Required {
+        if len(votes) < quorum.Required {
+            return nil, lastErr
+        }
+
+        for _, vote := range votes {
+            logUpstream(ctx, "Quorum " + quorum.String() + " for " + method + " not reached, upstream \"" + vote.upstream.status.Url + "\" answered: " + vote.key)
+        }
+        return nil, ErrInconsistentUpstreams
+    }
+
+    for _, vote := range votes {
+        if vote.key != winner.key {
+            logUpstream(ctx, "Upstream \"" + vote.upstream.status.Url + "\" diverged from quorum " + quorum.String() + " for " + method + ": " + vote.key)
+        }
+    }
+
+    return winner.resps, winner.err
+}
+
+// quorumCandidates returns every upstream, healthy ones first and best
+// score first.
+func (p *PoolRPCClient) quorumCandidates() []*poolUpstream {
+    candidates := p.candidates()
+
+    p.mu.Lock()
+    defer p.mu.Unlock()
+
+    if len(candidates) == len(p.upstreams) {
+        return candidates
+    }
+
+    for _, upstream := range p.upstreams {
+        if !upstream.status.Healthy {
+            candidates = append(candidates, upstream)
+        }
+    }
+    return candidates
+}
+
+// quorumKey is the canonical form of what the responses say, ignoring ids
+// and formatting.
+func quorumKey(resps [][]byte, err error) string {
+    if err != nil {
+        return "error: " + err.Error()
+    }
+
+    var key bytes.Buffer
+    for i, resp := range resps {
+        if i > 0 {
+            key.WriteString(",")
+        }
+
+        var rpcResp rpcResponse
+        if json.Unmarshal(resp, &rpcResp) != nil {
+            key.Write(resp)
+            continue
+        }
+
+        if rpcResp.Error != nil {
+            key.WriteString(rpcResp.Error.Error())
+            continue
+        }
+
+        key.WriteString(canonicalJSON(rpcResp.Result))
+    }
+
+    return key.String()
+}
\ No newline at end of file
@@ -0,0 +1,785 @@
+package router
+
+import (
+    "bytes"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "math/big"
+    "strconv"
+    "strings"
+)
+
+// EIP-2718 transaction types.
+const (
+    LegacyTxType byte = 0x00
+    AccessListTxType byte = 0x01
+    DynamicFeeTxType byte = 0x02
+    BlobTxType byte = 0x03
+    SetCodeTxType byte = 0x04
+)
+
+// DefaultTxFeeCap is the most a transaction may pay in fees, 1 ether, as
+// geth's default for eth_sendRawTransaction.
+var DefaultTxFeeCap, _ = new(big.Int).SetString("1000000000000000000", 10)
+
+// Intrinsic gas as of Prague.
+const (
+    txGas uint64 = 21000
+    txGasContractCreation uint64 = 53000
+    txDataZeroGas uint64 = 4
+    txDataNonZeroGas uint64 = 16
+    txInitCodeWordGas uint64 = 2
+    txAccessListAddressGas uint64 = 2400
+    txAccessListStorageKeyGas uint64 = 1900
+    txAuthorizationGas uint64 = 25000
+    // EIP-7623 floor, per token of calldata where a non-zero byte counts
+    // as four tokens.
+    txFloorTokenGas uint64 = 10
+)
+
+const blobCommitmentVersionKZG byte = 0x01
+const blobSize int = 131072
+const blobGasPerBlob uint64 = 131072
+const kzgCommitmentSize int = 48
+const kzgProofSize int = 48
+// kzgCellProofsPerBlob is the number of cell proofs per blob in the
+// EIP-7594 network wrapper.
+const kzgCellProofsPerBlob int = 128
+
+// maxRawTransactionSize is geth's limit on the size of a transaction in its
+// pool. Blob transactions may add a network wrapper of up to
+// maxBlobsPerTransaction blobs on top.
+const maxRawTransactionSize int = 128 * 1024
+const maxBlobsPerTransaction int = 6
+
+// Magic prefix of the EIP-7702 authorization signing hash.
+const setCodeAuthorizationMagic byte = 0x05
+
+type AccessTuple struct {
+    Address string `json:"address"`
+    StorageKeys []string `json:"storageKeys"`
+}
+
+// Authorization is an EIP-7702 delegation. Authority, the signer, is empty
+// when the signature does not recover; such authorizations are skipped when
+// the transaction runs, they do not make it invalid.
+type Authorization struct {
+    ChainId string `json:"chainId"`
+    Address string `json:"address"`
+    Nonce string `json:"nonce"`
+    YParity string `json:"yParity"`
+    R string `json:"r"`
+    S string `json:"s"`
+    Authority string `json:"authority,omitempty"`
+}
+
+// DecodedTransaction is a signed transaction as decoded from its raw
+// encoding, with the sender recovered from the signature. Fields not
+// carried by its type are empty.
+type DecodedTransaction struct {
+    Type string `json:"type"`
+    Hash string `json:"hash"`
+    From string `json:"from"`
+    ChainId string `json:"chainId,omitempty"`
+    Nonce string `json:"nonce"`
+    GasPrice string `json:"gasPrice,omitempty"`
+    MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas,omitempty"`
+    MaxFeePerGas string `json:"maxFeePerGas,omitempty"`
+    Gas string `json:"gas"`
+    To string `json:"to,omitempty"`
+    Value string `json:"value"`
+    Input string `json:"input"`
+    AccessList []AccessTuple `json:"accessList,omitempty"`
+    MaxFeePerBlobGas string `json:"maxFeePerBlobGas,omitempty"`
+    BlobVersionedHashes []string `json:"blobVersionedHashes,omitempty"`
+    AuthorizationList []Authorization `json:"authorizationList,omitempty"`
+    V string `json:"v"`
+    R string `json:"r"`
+    S string `json:"s"`
+    YParity string `json:"yParity,omitempty"`
+}
+
+// SendRawTransactionRequest carries a signed transaction in its raw
+// encoding, as taken by eth_sendRawTransaction.
+type SendRawTransactionRequest struct {
+    RawTransaction string `json:"rawTransaction"`
+}
+
+// SentTransaction is the hash the node accepted a transaction under,
+// with the transaction it decoded to.
+type SentTransaction struct {
+    Hash string `json:"hash"`
+    Transaction DecodedTransaction `json:"transaction"`
+}
+
+// rawTransaction holds the decoded values of a transaction checked before
+// it is sent. Legacy and access list transactions have their gas price as
+// both fee caps.
+type rawTransaction struct {
+    txType byte
+    // chainId is nil for legacy transactions signed without EIP-155.
+    chainId *big.Int
+    nonce uint64
+    gas uint64
+    gasTipCap *big.Int
+    gasFeeCap *big.Int
+    blobFeeCap *big.Int
+    blobCount int
+    intrinsicGas uint64
+    from string
+    decoded DecodedTransaction
+}
+
+// SendRawTransaction decodes and checks a signed transaction before handing
+// it to the node: the signature must recover a sender, the chain id must be
+// the node's, the nonce must be the sender's next one, the gas limit must
+// cover the intrinsic gas and fit a block, and the fees must be able to pay
+// the current base fee without exceeding TxFeeCap.
+func (svc EthServiceImp) SendRawTransaction(ctx context.Context, rawTx string) (interface{}, error) {
+    if !strings.HasPrefix(rawTx, "0x") {
+        return nil, rejectTransaction(TxRejectMalformed, "raw transaction must be 0x followed by hex", nil)
+    }
+    data, err := hex.DecodeString(rawTx[2:])
+    if err != nil {
+        return nil, rejectTransaction(TxRejectMalformed, "raw transaction must be 0x followed by hex", nil)
+    }
+
+    tx, err := decodeRawTransaction(data)
+    if err != nil {
+        return nil, err
+    }
+
+    err = svc.checkTransaction(ctx, tx)
+    if err != nil {
+        return nil, err
+    }
+
+    rpcReq := EthRPCRequest{}
+    rpcReq.constructSendRawTransactionRequest(rawTx)
+
+    var hash string
+    err = callRPC(ctx, svc.client, rpcReq, &hash)
+    if err != nil {
+        return nil, err
+    }
+
+    if hash != tx.decoded.Hash {
+        logUpstream(ctx, "Node accepted transaction " + tx.decoded.Hash + " under hash " + hash)
+    }
+
+    return SentTransaction{hash, tx.decoded}, nil
+}
+
+// checkTransaction compares tx with the chain id, the pending nonce of its
+// sender and the latest block, fetched in one batch.
+func (svc EthServiceImp) checkTransaction(ctx context.Context, tx *rawTransaction) error {
+    rpcReqs := make([]EthRPCRequest, 3)
+    rpcReqs[0].constructChainIdRequest()
+    rpcReqs[1].constructGetTransactionCountRequest(tx.from, "pending")
+    rpcReqs[2].constructGetBlockByNumberRequest("latest", false)
+
+    var chainId, nonce string
+    var head rpcBlock
+    err := callRPCBatch(ctx, svc.client, rpcReqs, []interface{}{&chainId, &nonce, &head})
+    if err != nil {
+        return err
+    }
+
+    nodeChainId, ok := decodeHexBig(chainId)
+    if !ok {
+        return ErrParsingInt
+    }
+    if tx.chainId == nil {
+        return rejectTransaction(TxRejectUnprotected, "transaction is not replay protected by EIP-155", map[string]string{
+            "expectedChainId": chainId,
+        })
+    }
+    if tx.chainId.Cmp(nodeChainId) != 0 {
+        return rejectTransaction(TxRejectWrongChain, "transaction is signed for another chain", map[string]string{
+            "expectedChainId": encodeHexBig(nodeChainId),
+            "chainId": tx.decoded.ChainId,
+        })
+    }
+
+    pendingNonce, err := strconv.ParseUint(strings.TrimPrefix(nonce, "0x"), 16, 64)
+    if err != nil {
+        return ErrParsingInt
+    }
+    if tx.nonce < pendingNonce {
+        return rejectTransaction(TxRejectNonceTooLow, "nonce too low", map[string]string{
+            "expectedNonce": nonce,
+            "nonce": tx.decoded.Nonce,
+        })
+    }
+    if tx.nonce > pendingNonce {
+        return rejectTransaction(TxRejectNonceTooHigh, "nonce too high, earlier nonces are missing", map[string]string{
+            "expectedNonce": nonce,
+            "nonce": tx.decoded.Nonce,
+        })
+    }
+
+    gasLimit, err := strconv.ParseUint(strings.TrimPrefix(head.GasLimit, "0x"), 16, 64)
+    if err != nil {
+        return ErrParsingInt
+    }
+    if tx.gas > gasLimit {
+        return rejectTransaction(TxRejectGasLimit, "gas limit exceeds the block gas limit", map[string]string{
+            "blockGasLimit": head.GasLimit,
+            "gas": tx.decoded.Gas,
+        })
+    }
+
+    // Nodes before London have no base fee.
+    if head.BaseFeePerGas != "" {
+        baseFee, ok := decodeHexBig(head.BaseFeePerGas)
+        if !ok {
+            return ErrParsingInt
+        }
+        if tx.gasFeeCap.Cmp(baseFee) < 0 {
+            return rejectTransaction(TxRejectFeeCapTooLow, "max fee per gas is below the base fee", map[string]string{
+                "baseFeePerGas": head.BaseFeePerGas,
+                "maxFeePerGas": encodeHexBig(tx.gasFeeCap),
+            })
+        }
+    }
+
+    if svc.TxFeeCap != nil && svc.TxFeeCap.Sign() > 0 {
+        fee := tx.maxFee()
+        if fee.Cmp(svc.TxFeeCap) > 0 {
+            return rejectTransaction(TxRejectFeeTooHigh, "transaction fee exceeds the configured cap", map[string]string{
+                "fee": encodeHexBig(fee),
+                "feeCap": encodeHexBig(svc.TxFeeCap),
+            })
+        }
+    }
+
+    return nil
+}
+
+// maxFee is the most tx can pay in fees, blob gas included.
+func (tx *rawTransaction) maxFee() *big.Int {
+    fee := new(big.Int).Mul(tx.gasFeeCap, new(big.Int).SetUint64(tx.gas))
+    if tx.blobFeeCap != nil {
+        blobGas := new(big.Int).SetUint64(uint64(tx.blobCount) * blobGasPerBlob)
+        fee.Add(fee, blobGas.Mul(blobGas, tx.blobFeeCap))
+    }
+    return fee
+}
+
+/* ----- DECODING ----- */
+
+// txFields lists the fields of each typed transaction, signature included.
+var txFields = map[byte]int{
+    AccessListTxType: 11,
+    DynamicFeeTxType: 12,
+    BlobTxType: 14,
+    SetCodeTxType: 13,
+}
+
+// decodeRawTransaction decodes a signed legacy or EIP-2718 typed
+// transaction and checks what can be checked without the node.
+func decodeRawTransaction(data []byte) (*rawTransaction, error) {
+    if len(data) == 0 {
+        return nil, rejectTransaction(TxRejectMalformed, "empty transaction", nil)
+    }
+
+    maxSize := maxRawTransactionSize
+    if data[0] == BlobTxType {
+        maxSize += maxBlobsPerTransaction * (blobSize + kzgCommitmentSize + kzgCellProofsPerBlob * kzgProofSize)
+    }
+    if len(data) > maxSize {
+        return nil, rejectTransaction(TxRejectMalformed, "transaction is larger than " + strconv.Itoa(maxSize) + " bytes", map[string]string{
+            "size": strconv.Itoa(len(data)),
+        })
+    }
+
+    // Legacy transactions are RLP lists, whose first byte is at least 0xc0.
+    if data[0] >= 0xc0 {
+        item, err := decodeRLP(data)
+        if err != nil {
+            return nil, malformedTransaction(err)
+        }
+        return decodeLegacyTransaction(item, data)
+    }
+
+    txType := data[0]
+    fieldCount, ok := txFields[txType]
+    if !ok {
+        return nil, rejectTransaction(TxRejectUnsupportedType, "unsupported transaction type " + encodeHexInt(int64(txType)), nil)
+    }
+
+    item, err := decodeRLP(data[1:])
+    if err != nil {
+        return nil, malformedTransaction(err)
+    }
+    if !item.list {
+        return nil, rejectTransaction(TxRejectMalformed, "transaction payload is not a list", nil)
+    }
+
+    // Blob transactions are sent in the network wrapper, with the blobs,
+    // their commitments and proofs next to the transaction.
+    var sidecar []rlpItem
+    if txType == BlobTxType && len(item.items) > 0 && item.items[0].list {
+        sidecar = item.items[1:]
+        item = item.items[0]
+    }
+
+    if len(item.items) != fieldCount {
+        return nil, rejectTransaction(TxRejectMalformed, "transaction of type " + encodeHexInt(int64(txType)) + " must have " + strconv.Itoa(fieldCount) + " fields", nil)
+    }
+
+    tx, err := decodeTypedTransaction(txType, item.items)
+    if err != nil {
+        return nil, err
+    }
+
+    if sidecar != nil {
+        err = checkBlobSidecar(tx.decoded.BlobVersionedHashes, sidecar)
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    // The hash covers the transaction without its network wrapper.
+    tx.decoded.Hash = "0x" + hex.EncodeToString(keccak256(append([]byte{txType}, item.raw...)))
+
+    return tx, checkIntrinsicGas(tx)
+}
+
+func decodeLegacyTransaction(item rlpItem, data []byte) (*rawTransaction, error) {
+    if len(item.items) != 9 {
+        return nil, rejectTransaction(TxRejectMalformed, "legacy transaction must have 9 fields", nil)
+    }
+    fields := item.items
+
+    tx := &rawTransaction{txType: LegacyTxType}
+    d := &tx.decoded
+    d.Type = encodeHexInt(int64(LegacyTxType))
+
+    var err error
+    tx.gasFeeCap, err = decodeTxUint(fields[1], 32, "gasPrice")
+    if err != nil {
+        return nil, err
+    }
+    tx.gasTipCap = tx.gasFeeCap
+    d.GasPrice = encodeHexBig(tx.gasFeeCap)
+
+    err = decodeTxCommonFields(tx, fields[0], fields[2], fields[3], fields[4], fields[5], false)
+    if err != nil {
+        return nil, err
+    }
+
+    v, err := decodeTxUint(fields[6], 32, "v")
+    if err != nil {
+        return nil, err
+    }
+    r, s, err := decodeTxSignatureValues(fields[7], fields[8])
+    if err != nil {
+        return nil, err
+    }
+    d.V, d.R, d.S = encodeHexBig(v), encodeHexBig(r), encodeHexBig(s)
+
+    // v is 27 or 28 without EIP-155, chainId * 2 + 35 or 36 with it.
+    var yParity uint
+    var unsigned []byte
+    switch {
+    case v.Cmp(big.NewInt(27)) == 0 || v.Cmp(big.NewInt(28)) == 0:
+        yParity = uint(v.Int64() - 27)
+        unsigned = encodeRLPList(fields[0].raw, fields[1].raw, fields[2].raw, fields[3].raw, fields[4].raw, fields[5].raw)
+    case v.Cmp(big.NewInt(35)) >= 0:
+        offset := new(big.Int).Sub(v, big.NewInt(35))
+        yParity = offset.Bit(0)
+        tx.chainId = offset.Rsh(offset, 1)
+        d.ChainId = encodeHexBig(tx.chainId)
+        unsigned = encodeRLPList(fields[0].raw, fields[1].raw, fields[2].raw, fields[3].raw, fields[4].raw, fields[5].raw,
+            encodeRLPUint(tx.chainId), encodeRLPUint(new(big.Int)), encodeRLPUint(new(big.Int)))
+    default:
+        return nil, rejectTransaction(TxRejectInvalidSignature, "invalid v " + d.V, nil)
+    }
+
+    err = recoverSender(tx, keccak256(unsigned), r, s, yParity)
+    if err != nil {
+        return nil, err
+    }
+
+    d.Hash = "0x" + hex.EncodeToString(keccak256(data))
+    return tx, checkIntrinsicGas(tx)
+}
+
+// decodeTypedTransaction decodes the fields of an EIP-2718 transaction,
+// which all start with the chain id and nonce and end with the access list,
+// type specific fields and the signature.
+func decodeTypedTransaction(txType byte, fields []rlpItem) (*rawTransaction, error) {
+    tx := &rawTransaction{txType: txType}
+    d := &tx.decoded
+    d.Type = encodeHexInt(int64(txType))
+
+    var err error
+    tx.chainId, err = decodeTxUint(fields[0], 32, "chainId")
+    if err != nil {
+        return nil, err
+    }
+    d.ChainId = encodeHexBig(tx.chainId)
+
+    // Access list transactions pay a gas price, the later types a priority
+    // fee on top of the base fee up to a fee cap.
+    next := 2
+    if txType == AccessListTxType {
+        tx.gasFeeCap, err = decodeTxUint(fields[2], 32, "gasPrice")
+        if err != nil {
+            return nil, err
+        }
+        tx.gasTipCap = tx.gasFeeCap
+        d.GasPrice = encodeHexBig(tx.gasFeeCap)
+        next = 3
+    } else {
+        tx.gasTipCap, err = decodeTxUint(fields[2], 32, "maxPriorityFeePerGas")
+        if err != nil {
+            return nil, err
+        }
+        tx.gasFeeCap, err = decodeTxUint(fields[3], 32, "maxFeePerGas")
+        if err != nil {
+            return nil, err
+        }
+        d.MaxPriorityFeePerGas = encodeHexBig(tx.gasTipCap)
+        d.MaxFeePerGas = encodeHexBig(tx.gasFeeCap)
+        next = 4
+
+        if tx.gasTipCap.Cmp(tx.gasFeeCap) > 0 {
+            return nil, rejectTransaction(TxRejectTipAboveFeeCap, "max priority fee per gas is above max fee per gas", map[string]string{
+                "maxPriorityFeePerGas": d.MaxPriorityFeePerGas,
+                "maxFeePerGas": d.MaxFeePerGas,
+            })
+        }
+    }
+
+    // Blob and set code transactions cannot create contracts.
+    requireTo := txType == BlobTxType || txType == SetCodeTxType
+    err = decodeTxCommonFields(tx, fields[1], fields[next], fields[next + 1], fields[next + 2], fields[next + 3], requireTo)
+    if err != nil {
+        return nil, err
+    }
+
+    accessList, keys, err := decodeAccessList(fields[next + 4])
+    if err != nil {
+        return nil, err
+    }
+    d.AccessList = accessList
+    tx.intrinsicGas = uint64(len(accessList)) * txAccessListAddressGas + uint64(keys) * txAccessListStorageKeyGas
+    next += 5
+
+    switch txType {
+    case BlobTxType:
+        tx.blobFeeCap, err = decodeTxUint(fields[next], 32, "maxFeePerBlobGas")
+        if err != nil {
+            return nil, err
+        }
+        d.MaxFeePerBlobGas = encodeHexBig(tx.blobFeeCap)
+
+        d.BlobVersionedHashes, err = decodeBlobHashes(fields[next + 1])
+        if err != nil {
+            return nil, err
+        }
+        tx.blobCount = len(d.BlobVersionedHashes)
+        next += 2
+    case SetCodeTxType:
+        d.AuthorizationList, err = decodeAuthorizationList(fields[next])
+        if err != nil {
+            return nil, err
+        }
+        tx.intrinsicGas += uint64(len(d.AuthorizationList)) * txAuthorizationGas
+        next++
+    }
+
+    yParity, err := decodeTxUint(fields[next], 1, "yParity")
+    if err != nil {
+        return nil, err
+    }
+    r, s, err := decodeTxSignatureValues(fields[next + 1], fields[next + 2])
+    if err != nil {
+        return nil, err
+    }
+    d.YParity, d.V, d.R, d.S = encodeHexBig(yParity), encodeHexBig(yParity), encodeHexBig(r), encodeHexBig(s)
+
+    raws := make([][]byte, next)
+    for i := range raws {
+        raws[i] = fields[i].raw
+    }
+    unsigned := append([]byte{txType}, encodeRLPList(raws...)...)
+
+    err = recoverSender(tx, keccak256(unsigned), r, s, uint(yParity.Uint64()))
+    if err != nil {
+        return nil, err
+    }
+    return tx, nil
+}
+
+// decodeTxCommonFields decodes the nonce, gas limit, recipient, value and
+// input every transaction type carries.
+func decodeTxCommonFields(tx *rawTransaction, nonce rlpItem, gas rlpItem, to rlpItem, value rlpItem, input rlpItem, requireTo bool) error {
+    d := &tx.decoded
+
+    n, err := decodeTxUint(nonce, 8, "nonce")
+    if err != nil {
+        return err
+    }
+    tx.nonce = n.Uint64()
+    d.Nonce = encodeHexBig(n)
+
+    n, err = decodeTxUint(gas, 8, "gas")
+    if err != nil {
+        return err
+    }
+    tx.gas = n.Uint64()
+    d.Gas = encodeHexBig(n)
+
+    if to.list || len(to.data) != 0 && len(to.data) != 20 {
+        return rejectTransaction(TxRejectMalformed, "to must be empty or 20 bytes", nil)
+    }
+    if len(to.data) == 0 && requireTo {
+        return rejectTransaction(TxRejectMalformed, "transaction of type " + d.Type + " cannot create a contract", nil)
+    }
+    if len(to.data) == 20 {
+        d.To = "0x" + hex.EncodeToString(to.data)
+    }
+
+    n, err = decodeTxUint(value, 32, "value")
+    if err != nil {
+        return err
+    }
+    d.Value = encodeHexBig(n)
+
+    if input.list {
+        return rejectTransaction(TxRejectMalformed, "input must be a byte string", nil)
+    }
+    d.Input = "0x" + hex.EncodeToString(input.data)
+
+    return nil
+}
+
+// decodeAccessList returns the access list and the number of storage keys
+// in it.
+func decodeAccessList(item rlpItem) ([]AccessTuple, int, error) {
+    if !item.list {
+        return nil, 0, rejectTransaction(TxRejectMalformed, "access list must be a list", nil)
+    }
+
+    accessList := []AccessTuple{}
+    keys := 0
+    for _, entry := range item.items {
+        if !entry.list || len(entry.items) != 2 || entry.items[0].list || len(entry.items[0].data) != 20 || !entry.items[1].list {
+            return nil, 0, rejectTransaction(TxRejectMalformed, "access list entries must be [address, [storage keys]]", nil)
+        }
+
+        tuple := AccessTuple{Address: "0x" + hex.EncodeToString(entry.items[0].data), StorageKeys: []string{}}
+        for _, key := range entry.items[1].items {
+            if key.list || len(key.data) != 32 {
+                return nil, 0, rejectTransaction(TxRejectMalformed, "access list storage keys must be 32 bytes", nil)
+            }
+            tuple.StorageKeys = append(tuple.StorageKeys, "0x" + hex.EncodeToString(key.data))
+        }
+        keys += len(tuple.StorageKeys)
+        accessList = append(accessList, tuple)
+    }
+    return accessList, keys, nil
+}
+
+func decodeBlobHashes(item rlpItem) ([]string, error) {
+    if !item.list || len(item.items) == 0 {
+        return nil, rejectTransaction(TxRejectMalformed, "blob transaction must carry blob versioned hashes", nil)
+    }
+
+    hashes := []string{}
+    for _, hash := range item.items {
+        if hash.list || len(hash.data) != 32 || hash.data[0] != blobCommitmentVersionKZG {
+            return nil, rejectTransaction(TxRejectMalformed, "blob versioned hashes must be 32 bytes of version 0x01", nil)
+        }
+        hashes = append(hashes, "0x" + hex.EncodeToString(hash.data))
+    }
+    return hashes, nil
+}
+
+// checkBlobSidecar checks that the network wrapper of a blob transaction
+// holds a blob and commitment per versioned hash, each commitment hashing to
+// its versioned hash, and either a proof per blob or, with the EIP-7594
+// wrapper version in front, its cell proofs. The KZG proofs themselves are
+// left to the node.
+func checkBlobSidecar(versionedHashes []string, sidecar []rlpItem) error {
+    proofsPerBlob := 1
+    if len(sidecar) == 4 && !sidecar[0].list {
+        proofsPerBlob = kzgCellProofsPerBlob
+        sidecar = sidecar[1:]
+    }
+    if len(sidecar) != 3 || !sidecar[0].list || !sidecar[1].list || !sidecar[2].list {
+        return rejectTransaction(TxRejectMalformed, "blob transaction wrapper must hold blobs, commitments and proofs", nil)
+    }
+
+    blobs, commitments, proofs := sidecar[0].items, sidecar[1].items, sidecar[2].items
+    count := len(versionedHashes)
+    if len(blobs) != count || len(commitments) != count || len(proofs) != count * proofsPerBlob {
+        return rejectTransaction(TxRejectMalformed, "blob transaction wrapper does not match its versioned hashes", map[string]string{
+            "versionedHashes": strconv.Itoa(count),
+            "blobs": strconv.Itoa(len(blobs)),
+            "commitments": strconv.Itoa(len(commitments)),
+            "proofs": strconv.Itoa(len(proofs)),
+        })
+    }
+
+    for i, blob := range blobs {
+        if blob.list || len(blob.data) != blobSize {
+            return rejectTransaction(TxRejectMalformed, "blobs must be " + strconv.Itoa(blobSize) + " bytes", nil)
+        }
+
+        commitment := commitments[i]
+        if commitment.list || len(commitment.data) != kzgCommitmentSize {
+            return rejectTransaction(TxRejectMalformed, "KZG commitments must be " + strconv.Itoa(kzgCommitmentSize) + " bytes", nil)
+        }
+
+        hash := sha256.Sum256(commitment.data)
+        hash[0] = blobCommitmentVersionKZG
+        if "0x" + hex.EncodeToString(hash[:]) != versionedHashes[i] {
+            return rejectTransaction(TxRejectMalformed, "KZG commitment does not match its versioned hash", map[string]string{
+                "versionedHash": versionedHashes[i],
+            })
+        }
+    }
+
+    for _, proof := range proofs {
+        if proof.list || len(proof.data) != kzgProofSize {
+            return rejectTransaction(TxRejectMalformed, "KZG proofs must be " + strconv.Itoa(kzgProofSize) + " bytes", nil)
+        }
+    }
+    return nil
+}
+
+func decodeAuthorizationList(item rlpItem) ([]Authorization, error) {
+    if !item.list || len(item.items) == 0 {
+        return nil, rejectTransaction(TxRejectMalformed, "set code transaction must carry authorizations", nil)
+    }
+
+    authorizations := []Authorization{}
+    for _, entry := range item.items {
+        if !entry.list || len(entry.items) != 6 || entry.items[1].list || len(entry.items[1].data) != 20 {
+            return nil, rejectTransaction(TxRejectMalformed, "authorizations must be [chainId, address, nonce, yParity, r, s]", nil)
+        }
+        fields := entry.items
+
+        chainId, err := decodeTxUint(fields[0], 32, "authorization chainId")
+        if err != nil {
+            return nil, err
+        }
+        nonce, err := decodeTxUint(fields[2], 8, "authorization nonce")
+        if err != nil {
+            return nil, err
+        }
+        yParity, err := decodeTxUint(fields[3], 1, "authorization yParity")
+        if err != nil {
+            return nil, err
+        }
+        r, err := decodeTxUint(fields[4], 32, "authorization r")
+        if err != nil {
+            return nil, err
+        }
+        s, err := decodeTxUint(fields[5], 32, "authorization s")
+        if err != nil {
+            return nil, err
+        }
+
+        authorization := Authorization{
+            ChainId: encodeHexBig(chainId),
+            Address: "0x" + hex.EncodeToString(fields[1].data),
+            Nonce: encodeHexBig(nonce),
+            YParity: encodeHexBig(yParity),
+            R: encodeHexBig(r),
+            S: encodeHexBig(s),
+        }
+
+        hash := keccak256(append([]byte{setCodeAuthorizationMagic}, encodeRLPList(fields[0].raw, fields[1].raw, fields[2].raw)...))
+        authority, err := recoverAddress(hash, r, s, uint(yParity.Uint64()))
+        if err == nil {
+            authorization.Authority = "0x" + hex.EncodeToString(authority)
+        }
+
+        authorizations = append(authorizations, authorization)
+    }
+    return authorizations, nil
+}
+
+func decodeTxUint(item rlpItem, maxBytes int, name string) (*big.Int, error) {
+    n, err := item.bigInt(maxBytes)
+    if err != nil {
+        return nil, rejectTransaction(TxRejectMalformed, name + " is not a valid integer of at most " + strconv.Itoa(maxBytes) + " bytes", nil)
+    }
+    return n, nil
+}
+
+func decodeTxSignatureValues(rItem rlpItem, sItem rlpItem) (*big.Int, *big.Int, error) {
+    r, err := decodeTxUint(rItem, 32, "r")
+    if err != nil {
+        return nil, nil, err
+    }
+    s, err := decodeTxUint(sItem, 32, "s")
+    if err != nil {
+        return nil, nil, err
+    }
+    return r, s, nil
+}
+
+func recoverSender(tx *rawTransaction, hash []byte, r *big.Int, s *big.Int, yParity uint) error {
+    sender, err := recoverAddress(hash, r, s, yParity)
+    if err != nil {
+        return rejectTransaction(TxRejectInvalidSignature, "signature does not recover a sender", nil)
+    }
+
+    tx.from = "0x" + hex.EncodeToString(sender)
+    tx.decoded.From = tx.from
+    return nil
+}
+
+// checkIntrinsicGas checks that the gas limit covers the gas a transaction
+// costs before any code runs, and the EIP-7623 floor for its calldata.
+// Access list and authorization costs are already in tx.intrinsicGas.
+func checkIntrinsicGas(tx *rawTransaction) error {
+    input, _ := hex.DecodeString(tx.decoded.Input[2:])
+    nonZero := uint64(len(input) - bytes.Count(input, []byte{0}))
+    zero := uint64(len(input)) - nonZero
+
+    gas := txGas
+    if tx.decoded.To == "" {
+        gas = txGasContractCreation + (uint64(len(input)) + 31) / 32 * txInitCodeWordGas
+    }
+    gas += tx.intrinsicGas + zero * txDataZeroGas + nonZero * txDataNonZeroGas
+
+    floor := txGas + (zero + nonZero * 4) * txFloorTokenGas
+    if floor > gas {
+        gas = floor
+    }
+
+    if tx.gas < gas {
+        return rejectTransaction(TxRejectIntrinsicGas, "gas limit is below the intrinsic gas", map[string]string{
+            "intrinsicGas": "0x" + strconv.FormatUint(gas, 16),
+            "gas": tx.decoded.Gas,
+        })
+    }
+    return nil
+}
+
+func malformedTransaction(err error) error {
+    return rejectTransaction(TxRejectMalformed, "invalid RLP encoding: " + err.Error(), nil)
+}
+
+func rejectTransaction(reason string, message string, details map[string]string) *TransactionRejectedError {
+    return &TransactionRejectedError{reason, message, details}
+}
+
+func encodeHexBig(n *big.Int) string {
+    return "0x" + n.Text(16)
+}
+
+func decodeHexBig(hexInt string) (*big.Int, bool) {
+    if !strings.HasPrefix(hexInt, "0x") {
+        return nil, false
+    }
+    return new(big.Int).SetString(hexInt[2:], 16)
+}
\ No newline at end of file
@@ -0,0 +1,251 @@
+package router
+
+import (
+    "crypto/sha256"
+    "encoding/hex"
+    "math/big"
+    "testing"
+)
+
+// Signed by the key 0x4646...46, the example of EIP-155.
+const eip155Tx = "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"
+
+// Signed by the first and second development keys of Hardhat and Anvil,
+// 0xac09...ff80 for 0xf39f...2266 and 0x59c6...690d for 0x7099...79c8.
+const (
+    legacyTx = "0xf86c808504a817c800825208943535353535353535353535353535353535353535880de0b6b3a7640000801ba07b33947311d3c11a774869fd9844db278257f1616e98ec95b2c7ea0fc3684c37a02bd67c3e6b41ff0a1d26c0d1d7a7e4fa8c44892e07be66cf564f8ba083df3193"
+    accessListTx = "0x01f89f01018504a817c8008275309435353535353535353535353535353535353535358080f838f7943535353535353535353535353535353535353535e1a0000000000000000000000000000000000000000000000000000000000000000101a0cd22bdbd83e93c49c1488020b6a62e934fcd5a78852deb15c9f473bb6449553da04a55e18e30deb5f5814e824ec37088cb15eb081847471813e0dd91336fada93d"
+    dynamicFeeTx = "0x02f8b00102843b9aca008506fc23ac0082ea6094353535353535353535353535353535353535353580b844a9059cbb00000000000000000000000070997970c51812dc3a010c7d01b50e0d17dc79c800000000000000000000000000000000000000000000000000000000000003e8c080a0ce1523a86e2b549558690c169008c849d56de87fadb4866bfcd5db2af162e7afa01555dbcccfa69136f744ecc2b1a95e2c96747faefb099fcffdc0a21f1b678e36"
+    blobTx = "0x03f8920103843b9aca008506fc23ac008252089435353535353535353535353535353535353535358080c0843b9aca00e1a0010657f37554c781402a22917dee2f75def7ab966d7b770905398eba3c44401480a093600cb959096f5422a021370f57eb9547d8eb0eeabf4b2838af77b16d972fa5a00bc6a31690a5efef1b83bd2298147f9ced1c61b594b0e4305a0d44c992a0e06b"
+    setCodeTx = "0x04f8c90104843b9aca008506fc23ac0082c35094f39fd6e51aad88f6f4ce6ab8827279cfffb922668080c0f85cf85a019435353535353535353535353535353535353535358001a0e684723d19bb72246905e771a3b0037278fa9facca0e8b20eec59fe24492f487a00610ddb87ada89d61edbd97f16f56c7b9a2aae2cfa95cd6e573b5ff8b3c8efe001a048bea3c131c4b587440117c903c8c703e4859ecd533158a5f86db63e1300d770a01d528374a1166e187510ff67eb9aa5aec0f825a635de0b833181e735e9f2ddb8"
+)
+
+// The versioned hash in blobTx is that of this commitment, the one of the
+// zero blob.
+var blobTxCommitment = append([]byte{0xc0}, make([]byte, kzgCommitmentSize - 1)...)
+
+func decodeTestHex(t *testing.T, s string) []byte {
+    data, err := hex.DecodeString(s[2:])
+    if err != nil {
+        t.Fatal(err)
+    }
+    return data
+}
+
+// editRawTransaction returns raw with edit applied to the encodings of its
+// fields.
+func editRawTransaction(t *testing.T, raw string, edit func(fields [][]byte)) []byte {
+    data := decodeTestHex(t, raw)
+    var prefix []byte
+    if data[0] < 0xc0 {
+        prefix, data = data[:1], data[1:]
+    }
+
+    item, err := decodeRLP(data)
+    if err != nil {
+        t.Fatal(err)
+    }
+    fields := make([][]byte, len(item.items))
+    for i, field := range item.items {
+        fields[i] = field.raw
+    }
+    edit(fields)
+    return append(append([]byte{}, prefix...), encodeRLPList(fields...)...)
+}
+
+// blobTxWrapper wraps blobTx with a zero blob, the given commitment and a
+// zero proof.
+func blobTxWrapper(t *testing.T, commitment []byte) []byte {
+    data := decodeTestHex(t, blobTx)
+    sidecar := [][]byte{
+        encodeRLPList(encodeRLPString(make([]byte, blobSize))),
+        encodeRLPList(encodeRLPString(commitment)),
+        encodeRLPList(encodeRLPString(make([]byte, kzgProofSize))),
+    }
+    return append([]byte{BlobTxType}, encodeRLPList(append([][]byte{data[1:]}, sidecar...)...)...)
+}
+
+func rejection(err error) string {
+    if rejected, ok := err.(*TransactionRejectedError); ok {
+        return rejected.Reason
+    }
+    return ""
+}
+
+func TestDecodeRawTransactionKnownAnswers(t *testing.T) {
+    vectors := []struct {
+        name string
+        raw []byte
+        hash string
+        from string
+        chainId string
+    }{
+        {"eip-155", decodeTestHex(t, eip155Tx), "0x33469b22e9f636356c4160a87eb19df52b7412e8eac32a4a55ffe88ea8350788", "0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", "0x1"},
+        {"legacy", decodeTestHex(t, legacyTx), "0x70d8392e598c178a070437cf23421d7845da3a9640759c4002015135415b434e", "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", ""},
+        {"access list", decodeTestHex(t, accessListTx), "0x5591a22cc819935578f7fad6d8ba2d5b448956c84ad6d0e59c2aef5632e4a28a", "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "0x1"},
+        {"dynamic fee", decodeTestHex(t, dynamicFeeTx), "0xc6526e2c31f3b18fdf0fca4fe038e38b5d2f23cd3462d9961a5cbbe42c9eec43", "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "0x1"},
+        {"blob", decodeTestHex(t, blobTx), "0xc31b0b3e022b2338b1939fe7cf1ab7324f1369ee18bf207e24c241d4297906fd", "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "0x1"},
+        {"blob wrapper", blobTxWrapper(t, blobTxCommitment), "0xc31b0b3e022b2338b1939fe7cf1ab7324f1369ee18bf207e24c241d4297906fd", "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "0x1"},
+        {"set code", decodeTestHex(t, setCodeTx), "0x5e70c1536f7a0999cc57a04a1bbf7f6254672fb2d53462dfa2558bd7f7471911", "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "0x1"},
+    }
+
+    for _, v := range vectors {
+        tx, err := decodeRawTransaction(v.raw)
+        if err != nil {
+            t.Errorf("%s: %v", v.name, err)
+            continue
+        }
+        if tx.decoded.Hash != v.hash {
+            t.Errorf("%s: got hash %s, want %s", v.name, tx.decoded.Hash, v.hash)
+        }
+        if tx.decoded.From != v.from {
+            t.Errorf("%s: got sender %s, want %s", v.name, tx.decoded.From, v.from)
+        }
+        if tx.decoded.ChainId != v.chainId {
+            t.Errorf("%s: got chain id %q, want %q", v.name, tx.decoded.ChainId, v.chainId)
+        }
+    }
+
+    tx, err := decodeRawTransaction(decodeTestHex(t, setCodeTx))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(tx.decoded.AuthorizationList) != 1 || tx.decoded.AuthorizationList[0].Authority != "0x70997970c51812dc3a010c7d01b50e0d17dc79c8" {
+        t.Errorf("got authorizations %+v, want one by 0x7099...79c8", tx.decoded.AuthorizationList)
+    }
+
+    hash := sha256.Sum256(blobTxCommitment)
+    hash[0] = blobCommitmentVersionKZG
+    tx, err = decodeRawTransaction(decodeTestHex(t, blobTx))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(tx.decoded.BlobVersionedHashes) != 1 || tx.decoded.BlobVersionedHashes[0] != "0x" + hex.EncodeToString(hash[:]) {
+        t.Errorf("got versioned hashes %v, want the hash of the test commitment", tx.decoded.BlobVersionedHashes)
+    }
+}
+
+func TestDecodeRLPRejectsNonCanonicalEncodings(t *testing.T) {
+    cases := []struct {
+        data string
+        err error
+    }{
+        {"0x8105", errRLPNonCanonical},
+        {"0xc28105", errRLPNonCanonical},
+        {"0xb80105", errRLPNonCanonical},
+        {"0xb9003805", errRLPNonCanonical},
+        {"0xf800", errRLPNonCanonical},
+        {"0x820505", nil},
+        {"0x8205", errRLPMalformed},
+        {"0xc4820505", errRLPMalformed},
+        {"0x0505", errRLPTrailingBytes},
+    }
+
+    for _, c := range cases {
+        if _, err := decodeRLP(decodeTestHex(t, c.data)); err != c.err {
+            t.Errorf("%s: got %v, want %v", c.data, err, c.err)
+        }
+    }
+
+    leadingZero := editRawTransaction(t, legacyTx, func(fields [][]byte) {
+        fields[0] = encodeRLPString([]byte{0, 1})
+    })
+    if _, err := decodeRawTransaction(leadingZero); rejection(err) != TxRejectMalformed {
+        t.Errorf("nonce with a leading zero got %v, want %s", err, TxRejectMalformed)
+    }
+
+    nonCanonical := editRawTransaction(t, dynamicFeeTx, func(fields [][]byte) {
+        fields[6] = []byte{0x81, 0x00}
+    })
+    if _, err := decodeRawTransaction(nonCanonical); rejection(err) != TxRejectMalformed {
+        t.Errorf("non-canonical value got %v, want %s", err, TxRejectMalformed)
+    }
+}
+
+func TestDecodeRawTransactionRejectsBadSignatures(t *testing.T) {
+    // (r, N - s) with the other y parity is the same signature with s in
+    // the upper half of the curve order.
+    highS := func(raw string, v int, parity int64) []byte {
+        return editRawTransaction(t, raw, func(fields [][]byte) {
+            item, err := decodeRLP(fields[v + 2])
+            if err != nil {
+                t.Fatal(err)
+            }
+            fields[v] = encodeRLPUint(big.NewInt(parity))
+            fields[v + 2] = encodeRLPUint(new(big.Int).Sub(secp256k1N, new(big.Int).SetBytes(item.data)))
+        })
+    }
+    withV := func(raw string, v int, value int64) []byte {
+        return editRawTransaction(t, raw, func(fields [][]byte) {
+            fields[v] = encodeRLPUint(big.NewInt(value))
+        })
+    }
+
+    cases := []struct {
+        name string
+        raw []byte
+    }{
+        {"high s, eip-155", highS(eip155Tx, 6, 38)},
+        {"high s, legacy", highS(legacyTx, 6, 28)},
+        {"high s, dynamic fee", highS(dynamicFeeTx, 9, 1)},
+        {"v 0", withV(legacyTx, 6, 0)},
+        {"v 26", withV(legacyTx, 6, 26)},
+        {"v 29", withV(legacyTx, 6, 29)},
+        {"v 34", withV(legacyTx, 6, 34)},
+        {"y parity 2", withV(dynamicFeeTx, 9, 2)},
+    }
+
+    for _, c := range cases {
+        if _, err := decodeRawTransaction(c.raw); rejection(err) != TxRejectInvalidSignature {
+            t.Errorf("%s: got %v, want %s", c.name, err, TxRejectInvalidSignature)
+        }
+    }
+}
+
+func TestDecodeRawTransactionRejectsWrongBlobCommitment(t *testing.T) {
+    commitment := append([]byte{}, blobTxCommitment...)
+    commitment[1] = 1
+
+    _, err := decodeRawTransaction(blobTxWrapper(t, commitment))
+    rejected, ok := err.(*TransactionRejectedError)
+    if !ok || rejected.Reason != TxRejectMalformed || rejected.Message != "KZG commitment does not match its versioned hash" {
+        t.Errorf("got %v, want the commitment to be rejected", err)
+    }
+}
+
+// nestedRLPLists returns an empty list nested in depth - 1 lists.
+func nestedRLPLists(depth int) []byte {
+    headers := make([][]byte, depth)
+    size := 0
+    for i := range headers {
+        headers[i] = rlpHeader(0xc0, size)
+        size += len(headers[i])
+    }
+
+    data := []byte{}
+    for i := depth - 1; i >= 0; i-- {
+        data = append(data, headers[i]...)
+    }
+    return data
+}
+
+func TestDecodeRawTransactionRejectsOversizedInput(t *testing.T) {
+    if _, err := decodeRLP(nestedRLPLists(maxRLPDepth)); err != nil {
+        t.Errorf("lists nested %d deep got %v", maxRLPDepth, err)
+    }
+    for _, depth := range []int{maxRLPDepth + 1, 1 << 20} {
+        if _, err := decodeRLP(nestedRLPLists(depth)); err != errRLPTooDeep {
+            t.Errorf("lists nested %d deep got %v, want %v", depth, err, errRLPTooDeep)
+        }
+    }
+
+    large := editRawTransaction(t, legacyTx, func(fields [][]byte) {
+        fields[5] = encodeRLPString(make([]byte, maxRawTransactionSize))
+    })
+    if _, err := decodeRawTransaction(large); rejection(err) != TxRejectMalformed {
+        t.Errorf("transaction of %d bytes got %v, want %s", len(large), err, TxRejectMalformed)
+    }
+    if _, err := decodeRawTransaction(blobTxWrapper(t, blobTxCommitment)); err != nil {
+        t.Errorf("blob transaction in its wrapper got %v", err)
+    }
+}
\ No newline at end of file
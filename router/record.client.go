@@ -0,0 +1,327 @@
+package router
+
+import (
+    "bufio"
+    "context"
+    "encoding/json"
+    "errors"
+    "os"
+    "strconv"
+    "sync"
+    "time"
+)
+
+// transportErrors are given back as the same values when replayed, so
+// callers comparing against them behave as they did when recording.
+var transportErrors = []error{
+    ErrConnectingToGeth,
+    ErrReadingGethResponse,
+    ErrParsingJSON,
+    ErrResponseIdMismatch,
+    ErrBatchMismatch,
+    ErrUpstreamUnavailable,
+    ErrQuorumUnavailable,
+    ErrInconsistentUpstreams,
+    context.DeadlineExceeded,
+    context.Canceled,
+}
+
+// RecordedCall is one line of a recording: a JSON-RPC request, what the
+// node answered and how long it took. TransportError is set instead of
+// Result/Error when no answer was received, along with HTTPStatus when the
+// node answered with a status other than 200.
+type RecordedCall struct {
+    Time time.Time `json:"time"`
+    Method string `json:"method"`
+    Params json.RawMessage `json:"params"`
+    Result json.RawMessage `json:"result,omitempty"`
+    Error *RPCError `json:"error,omitempty"`
+    TransportError string `json:"transportError,omitempty"`
+    HTTPStatus int `json:"httpStatus,omitempty"`
+    LatencyMs float64 `json:"latencyMs"`
+}
+
+func newRecordedCall(rpcReq EthRPCRequest, resp []byte, err error, latency time.Duration) RecordedCall {
+    params, _ := json.Marshal(rpcReq.Params)
+    call := RecordedCall{
+        Time: time.Now(),
+        Method: rpcReq.Method,
+        Params: params,
+        LatencyMs: float64(latency) / float64(time.Millisecond),
+    }
+
+    if err != nil {
+        call.TransportError = err.Error()
+        if statusErr, ok := err.(*HTTPStatusError); ok {
+            call.HTTPStatus = statusErr.StatusCode
+        }
+        return call
+    }
+
+    var rpcResp rpcResponse
+    if json.Unmarshal(resp, &rpcResp) != nil {
+        call.TransportError = ErrParsingJSON.Error()
+        return call
+    }
+
+    call.Error = rpcResp.Error
+    call.Result = rpcResp.Result
+    if call.Error == nil && len(call.Result) == 0 {
+        call.Result = json.RawMessage("null")
+    }
+    return call
+}
+
+/* ----- RECORDING CLIENT ----- */
+
+// RecordingRPCClient passes calls through and appends every request and
+// its outcome to a JSONL file, one RecordedCall per line and per batch
+// element.
+type RecordingRPCClient struct {
+    client RPCClient
+
+    mu sync.Mutex
+    file *os.File
+    enc *json.Encoder
+}
+
+func NewRecordingRPCClient(client RPCClient, path string) (*RecordingRPCClient, error) {
+    file, err := os.OpenFile(path, os.O_CREATE | os.O_WRONLY | os.O_APPEND, 0644)
+    if err != nil {
+        return nil, err
+    }
+
+    return &RecordingRPCClient{
+        client: client,
+        file: file,
+        enc: json.NewEncoder(file),
+    }, nil
+}
+
+func (c *RecordingRPCClient) Unwrap() RPCClient {
+    return c.client
+}
+
+func (c *RecordingRPCClient) Close() error {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    return c.file.Close()
+}
+
+func (c *RecordingRPCClient) Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    started := time.Now()
+    resp, err := c.client.Call(ctx, rpcReq)
+
+    c.record(ctx, newRecordedCall(rpcReq, resp, err, time.Since(started)))
+    return resp, err
+}
+
+func (c *RecordingRPCClient) CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error) {
+    started := time.Now()
+    resps, err := c.client.CallBatch(ctx, rpcReqs)
+    latency := time.Since(started)
+
+    for i, rpcReq := range rpcReqs {
+        var resp []byte
+        if err == nil {
+            resp = resps[i]
+        }
+        c.record(ctx, newRecordedCall(rpcReq, resp, err, latency))
+    }
+    return resps, err
+}
+
+func (c *RecordingRPCClient) record(ctx context.Context, call RecordedCall) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    err := c.enc.Encode(call)
+    if err != nil {
+        logUpstream(ctx, "Recording " + call.Method + " failed: " + err.Error())
+    }
+}
+
+/* ----- REPLAY CLIENT ----- */
+
+// ReplayRPCClient answers calls from a recording instead of a node, matching
+// them by method and params. Calls recorded several times are answered in
+// recorded order, the last answer repeating.
+//
+// Unrecorded calls fail with ErrUnrecordedCall in Strict mode. Otherwise
+// they go to Fallback, or are answered with a null result without one.
+type ReplayRPCClient struct {
+    Strict bool
+    Fallback RPCClient
+    // SimulateLatency delays every answer by its recorded latency.
+    SimulateLatency bool
+
+    mu sync.Mutex
+    calls map[string][]RecordedCall
+    served map[string]int
+}
+
+func NewReplayRPCClient(path string) (*ReplayRPCClient, error) {
+    file, err := os.Open(path)
+    if err != nil {
+        return nil, err
+    }
+    defer file.Close()
+
+    c := &ReplayRPCClient{
+        calls: map[string][]RecordedCall{},
+        served: map[string]int{},
+    }
+
+    scanner := bufio.NewScanner(file)
+    scanner.Buffer(make([]byte, 64 * 1024), 64 * 1024 * 1024)
+    for line := 1; scanner.Scan(); line++ {
+        if len(scanner.Bytes()) == 0 {
+            continue
+        }
+
+        var call RecordedCall
+        err = json.Unmarshal(scanner.Bytes(), &call)
+        if err != nil {
+            return nil, errors.New("Error parsing recording \"" + path + "\" line " + strconv.Itoa(line) + ": " + err.Error())
+        }
+
+        key := replayKey(call.Method, call.Params)
+        c.calls[key] = append(c.calls[key], call)
+    }
+
+    err = scanner.Err()
+    if err != nil {
+        return nil, err
+    }
+
+    return c, nil
+}
+
+func (c *ReplayRPCClient) Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    call, ok := c.lookup(rpcReq)
+    if !ok {
+        return c.unrecorded(ctx, rpcReq)
+    }
+
+    err := c.wait(ctx, call)
+    if err != nil {
+        return nil, err
+    }
+
+    return replayResponse(call)
+}
+
+func (c *ReplayRPCClient) CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error) {
+    resps := make([][]byte, len(rpcReqs))
+    var slowest RecordedCall
+    for i, rpcReq := range rpcReqs {
+        call, ok := c.lookup(rpcReq)
+        if !ok {
+            resp, err := c.unrecorded(ctx, rpcReq)
+            if err != nil {
+                return nil, err
+            }
+            resps[i] = resp
+            continue
+        }
+
+        if call.LatencyMs > slowest.LatencyMs {
+            slowest = call
+        }
+
+        resp, err := replayResponse(call)
+        if err != nil {
+            return nil, err
+        }
+        resps[i] = resp
+    }
+
+    err := c.wait(ctx, slowest)
+    if err != nil {
+        return nil, err
+    }
+
+    return resps, nil
+}
+
+func (c *ReplayRPCClient) lookup(rpcReq EthRPCRequest) (RecordedCall, bool) {
+    params, _ := json.Marshal(rpcReq.Params)
+    key := replayKey(rpcReq.Method, params)
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    calls := c.calls[key]
+    if len(calls) == 0 {
+        return RecordedCall{}, false
+    }
+
+    i := c.served[key]
+    if i >= len(calls) {
+        i = len(calls) - 1
+    }
+    c.served[key]++
+
+    return calls[i], true
+}
+
+func (c *ReplayRPCClient) unrecorded(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    params, _ := json.Marshal(rpcReq.Params)
+    logUpstream(ctx, "No recorded answer for " + rpcReq.Method + " with params " + string(params))
+
+    if c.Strict {
+        return nil, ErrUnrecordedCall
+    }
+
+    if c.Fallback != nil {
+        return c.Fallback.Call(ctx, rpcReq)
+    }
+
+    return replayResponse(RecordedCall{Result: json.RawMessage("null")})
+}
+
+func (c *ReplayRPCClient) wait(ctx context.Context, call RecordedCall) error {
+    if !c.SimulateLatency || call.LatencyMs <= 0 {
+        return nil
+    }
+
+    select {
+    case <-time.After(time.Duration(call.LatencyMs * float64(time.Millisecond))):
+        return nil
+    case <-ctx.Done():
+        return ctx.Err()
+    }
+}
+
+func replayResponse(call RecordedCall) ([]byte, error) {
+    if call.HTTPStatus != 0 {
+        return nil, &HTTPStatusError{call.HTTPStatus}
+    }
+    if call.TransportError != "" {
+        for _, err := range transportErrors {
+            if err.Error() == call.TransportError {
+                return nil, err
+            }
+        }
+        return nil, errors.New(call.TransportError)
+    }
+
+    resp := rpcResponse{
+        Jsonrpc: "2.0",
+        Id: new(int32),
+        Result: call.Result,
+        Error: call.Error,
+    }
+    *resp.Id = nextRPCId()
+
+    jsonData, err := json.Marshal(resp)
+    if err != nil {
+        return nil, ErrEncodingJSON
+    }
+    return jsonData, nil
+}
+
+func replayKey(method string, params json.RawMessage) string {
+    return method + " " + canonicalJSON(params)
+}
\ No newline at end of file
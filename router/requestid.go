@@ -0,0 +1,102 @@
+package router
+
+import (
+    "context"
+    "crypto/rand"
+    "encoding/hex"
+    "fmt"
+    "log"
+    "net/http"
+    "sync/atomic"
+
+    "google.golang.org/grpc/metadata"
+)
+
+const RequestIdHeader string = "X-Request-ID"
+const requestIdMetadataKey string = "x-request-id"
+
+type requestIdContextKey struct{}
+
+var rpcIdCounter int32
+
+// nextRPCId hands out JSON-RPC ids that are unique across all upstream
+// clients for the lifetime of the process.
+func nextRPCId() int32 {
+    return atomic.AddInt32(&rpcIdCounter, 1)
+}
+
+func generateRequestId() string {
+    buf := make([]byte, 16)
+    _, err := rand.Read(buf)
+    if err != nil {
+        return fmt.Sprintf("%x", nextRPCId())
+    }
+
+    return hex.EncodeToString(buf)
+}
+
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+    return context.WithValue(ctx, requestIdContextKey{}, requestId)
+}
+
+func RequestIdFromContext(ctx context.Context) string {
+    if ctx == nil {
+        return ""
+    }
+
+    requestId, _ := ctx.Value(requestIdContextKey{}).(string)
+    return requestId
+}
+
+// logUpstream logs like log.Println, prefixed with the inbound request id
+// carried by ctx so every node call can be traced back to its API call.
+func logUpstream(ctx context.Context, v ...interface{}) {
+    requestId := RequestIdFromContext(ctx)
+    if requestId == "" {
+        log.Println(v...)
+        return
+    }
+
+    log.Println(append([]interface{}{"[" + requestId + "]"}, v...)...)
+}
+
+// requestIdFromHTTP is a go-kit ServerBefore hook taking the request id from
+// the X-Request-ID header, or generating one.
+func requestIdFromHTTP(ctx context.Context, r *http.Request) context.Context {
+    requestId := r.Header.Get(RequestIdHeader)
+    if requestId == "" {
+        requestId = generateRequestId()
+    }
+
+    return WithRequestId(ctx, requestId)
+}
+
+// requestIdToHTTP is a go-kit ServerAfter hook echoing the request id.
+func requestIdToHTTP(ctx context.Context, w http.ResponseWriter) context.Context {
+    w.Header().Set(RequestIdHeader, RequestIdFromContext(ctx))
+    return ctx
+}
+
+// requestIdFromGRPC is a go-kit ServerBefore hook taking the request id from
+// x-request-id metadata, or generating one.
+func requestIdFromGRPC(ctx context.Context, md metadata.MD) context.Context {
+    requestId := ""
+    if values := md.Get(requestIdMetadataKey); len(values) > 0 {
+        requestId = values[0]
+    }
+    if requestId == "" {
+        requestId = generateRequestId()
+    }
+
+    return WithRequestId(ctx, requestId)
+}
+
+// requestIdToGRPC is a go-kit ServerAfter hook echoing the request id in the
+// response header metadata.
+func requestIdToGRPC(ctx context.Context, header *metadata.MD, _ *metadata.MD) context.Context {
+    if *header == nil {
+        *header = metadata.MD{}
+    }
+    header.Set(requestIdMetadataKey, RequestIdFromContext(ctx))
+    return ctx
+}
\ No newline at end of file
@@ -0,0 +1,192 @@
+package router
+
+import (
+    "context"
+    "encoding/json"
+    "expvar"
+    "math"
+    "math/rand"
+    "net/http"
+    "strconv"
+    "time"
+)
+
+var retryMetrics = expvar.NewMap("upstream_retries")
+var retryExhaustedMetrics = expvar.NewMap("upstream_retries_exhausted")
+
+// nonIdempotentMethods are never retried unless a method policy says so.
+var nonIdempotentMethods = map[string]bool{
+    "eth_sendRawTransaction": true,
+    "eth_sendTransaction": true,
+}
+
+// RetryPolicy describes how often and how fast a failed call is repeated.
+// The n-th retry waits InitialBackoff * Multiplier^(n-1), capped at
+// MaxBackoff and spread by +/- Jitter (a fraction of the delay).
+type RetryPolicy struct {
+    MaxAttempts int
+    InitialBackoff time.Duration
+    MaxBackoff time.Duration
+    Multiplier float64
+    Jitter float64
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+    MaxAttempts: 3,
+    InitialBackoff: 100 * time.Millisecond,
+    MaxBackoff: 2 * time.Second,
+    Multiplier: 2,
+    Jitter: 0.2,
+}
+
+var noRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+func (p RetryPolicy) backoff(retry int) time.Duration {
+    delay := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry - 1))
+    if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+        delay = float64(p.MaxBackoff)
+    }
+
+    delay += delay * p.Jitter * (2 * rand.Float64() - 1)
+    return time.Duration(delay)
+}
+
+/* ----- RETRYING CLIENT ----- */
+
+// RetryRPCClient repeats calls that fail with transient errors: connection
+// failures, 429/502/503/504 responses and -32005 rate limit errors, but not
+// -32005 errors refusing an eth_getLogs query as too large. Only idempotent
+// methods are retried by default.
+type RetryRPCClient struct {
+    Policy RetryPolicy
+    // MethodPolicies override Policy per JSON-RPC method.
+    MethodPolicies map[string]RetryPolicy
+
+    client RPCClient
+}
+
+func NewRetryRPCClient(client RPCClient, policy RetryPolicy) *RetryRPCClient {
+    return &RetryRPCClient{
+        Policy: policy,
+        MethodPolicies: map[string]RetryPolicy{},
+        client: client,
+    }
+}
+
+func (c *RetryRPCClient) Unwrap() RPCClient {
+    return c.client
+}
+
+func (c *RetryRPCClient) Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    var resp []byte
+    err := c.withRetry(ctx, rpcReq.Method, c.policyFor(rpcReq.Method), func() error {
+        var err error
+        resp, err = c.client.Call(ctx, rpcReq)
+        if err == nil && isRateLimited(resp) {
+            return &RPCError{Code: RPCErrLimitExceeded, Message: "rate limited"}
+        }
+        return err
+    })
+
+    if rpcErr, ok := err.(*RPCError); ok && rpcErr.Code == RPCErrLimitExceeded && resp != nil {
+        // Let the caller decode the node's own error object.
+        return resp, nil
+    }
+    return resp, err
+}
+
+// CallBatch retries the whole batch; a batch is only retried when every
+// method in it may be.
+func (c *RetryRPCClient) CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error) {
+    method := "batch"
+    policy := c.Policy
+    for _, rpcReq := range rpcReqs {
+        methodPolicy := c.policyFor(rpcReq.Method)
+        if methodPolicy.MaxAttempts < policy.MaxAttempts {
+            method = rpcReq.Method
+            policy = methodPolicy
+        }
+    }
+
+    var resps [][]byte
+    err := c.withRetry(ctx, method, policy, func() error {
+        var err error
+        resps, err = c.client.CallBatch(ctx, rpcReqs)
+        if err != nil {
+            return err
+        }
+
+        for _, resp := range resps {
+            if isRateLimited(resp) {
+                return &RPCError{Code: RPCErrLimitExceeded, Message: "rate limited"}
+            }
+        }
+        return nil
+    })
+
+    if rpcErr, ok := err.(*RPCError); ok && rpcErr.Code == RPCErrLimitExceeded && resps != nil {
+        return resps, nil
+    }
+    return resps, err
+}
+
+func (c *RetryRPCClient) policyFor(method string) RetryPolicy {
+    if policy, ok := c.MethodPolicies[method]; ok {
+        return policy
+    }
+
+    if nonIdempotentMethods[method] {
+        return noRetryPolicy
+    }
+
+    return c.Policy
+}
+
+func (c *RetryRPCClient) withRetry(ctx context.Context, method string, policy RetryPolicy, call func() error) error {
+    err := call()
+    for attempt := 2; attempt <= policy.MaxAttempts && isRetryableError(err); attempt++ {
+        delay := policy.backoff(attempt - 1)
+        logUpstream(ctx, "Retrying " + method + " (attempt " + strconv.Itoa(attempt) + "/" + strconv.Itoa(policy.MaxAttempts) + ") in " + delay.String() + " after error: " + err.Error())
+        retryMetrics.Add(method, 1)
+
+        select {
+        case <-time.After(delay):
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+
+        err = call()
+    }
+
+    if policy.MaxAttempts > 1 && isRetryableError(err) {
+        logUpstream(ctx, "Giving up on " + method + " after " + strconv.Itoa(policy.MaxAttempts) + " attempts: " + err.Error())
+        retryExhaustedMetrics.Add(method, 1)
+    }
+
+    return err
+}
+
+func isRetryableError(err error) bool {
+    if err == nil {
+        return false
+    }
+
+    if rpcErr, ok := err.(*RPCError); ok {
+        return rpcErr.Code == RPCErrLimitExceeded && !isTooManyResults(rpcErr)
+    }
+
+    if statusErr, ok := err.(*HTTPStatusError); ok && statusErr.StatusCode == http.StatusTooManyRequests {
+        return true
+    }
+
+    return isConnectionError(err)
+}
+
+func isRateLimited(resp []byte) bool {
+    var errResp rpcResponse
+    if json.Unmarshal(resp, &errResp) != nil {
+        return false
+    }
+
+    return errResp.Error != nil && errResp.Error.Code == RPCErrLimitExceeded && !isTooManyResults(errResp.Error)
+}
\ No newline at end of file
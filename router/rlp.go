@@ -0,0 +1,165 @@
+package router
+
+import (
+    "errors"
+    "math/big"
+)
+
+var errRLPMalformed = errors.New("malformed RLP")
+var errRLPNonCanonical = errors.New("non-canonical RLP")
+var errRLPTrailingBytes = errors.New("trailing bytes after RLP item")
+var errRLPTooDeep = errors.New("RLP lists nested too deep")
+
+// maxRLPDepth bounds the nesting of lists. The deepest transaction, a blob
+// transaction in its network wrapper with storage keys in its access list,
+// nests five deep.
+const maxRLPDepth int = 8
+
+// rlpItem is a decoded RLP item: a byte string, or a list of items when
+// list is set. raw holds the item's own encoding.
+type rlpItem struct {
+    list bool
+    data []byte
+    items []rlpItem
+    raw []byte
+}
+
+// decodeRLP decodes data, which must hold exactly one canonically encoded
+// item, with lists nested at most maxRLPDepth deep.
+func decodeRLP(data []byte) (rlpItem, error) {
+    item, rest, err := decodeRLPItem(data, 0)
+    if err != nil {
+        return item, err
+    }
+    if len(rest) > 0 {
+        return item, errRLPTrailingBytes
+    }
+    return item, nil
+}
+
+func decodeRLPItem(data []byte, depth int) (rlpItem, []byte, error) {
+    if len(data) == 0 {
+        return rlpItem{}, nil, errRLPMalformed
+    }
+
+    prefix := data[0]
+    var list bool
+    var offset, size uint64
+    switch {
+    case prefix < 0x80:
+        return rlpItem{data: data[:1], raw: data[:1]}, data[1:], nil
+    case prefix < 0xb8:
+        offset, size = 1, uint64(prefix - 0x80)
+    case prefix < 0xc0:
+        lenSize := uint64(prefix - 0xb7)
+        n, err := rlpLength(data, lenSize)
+        if err != nil {
+            return rlpItem{}, nil, err
+        }
+        offset, size = 1 + lenSize, n
+    case prefix < 0xf8:
+        list = true
+        offset, size = 1, uint64(prefix - 0xc0)
+    default:
+        list = true
+        lenSize := uint64(prefix - 0xf7)
+        n, err := rlpLength(data, lenSize)
+        if err != nil {
+            return rlpItem{}, nil, err
+        }
+        offset, size = 1 + lenSize, n
+    }
+
+    if size > uint64(len(data)) - offset {
+        return rlpItem{}, nil, errRLPMalformed
+    }
+    end := offset + size
+    item := rlpItem{list: list, data: data[offset:end], raw: data[:end]}
+
+    if !list {
+        // A single byte below 0x80 is its own encoding.
+        if size == 1 && item.data[0] < 0x80 {
+            return rlpItem{}, nil, errRLPNonCanonical
+        }
+        return item, data[end:], nil
+    }
+
+    if depth >= maxRLPDepth {
+        return rlpItem{}, nil, errRLPTooDeep
+    }
+
+    content := item.data
+    for len(content) > 0 {
+        child, rest, err := decodeRLPItem(content, depth + 1)
+        if err != nil {
+            return rlpItem{}, nil, err
+        }
+        item.items = append(item.items, child)
+        content = rest
+    }
+    return item, data[end:], nil
+}
+
+// rlpLength reads the lenSize bytes long payload length of a long string
+// or list, which must not fit the short form nor start with a zero.
+func rlpLength(data []byte, lenSize uint64) (uint64, error) {
+    if lenSize > 8 || uint64(len(data)) < 1 + lenSize {
+        return 0, errRLPMalformed
+    }
+    if data[1] == 0 {
+        return 0, errRLPNonCanonical
+    }
+
+    var n uint64
+    for _, b := range data[1:1 + lenSize] {
+        n = n << 8 | uint64(b)
+    }
+    if n < 56 {
+        return 0, errRLPNonCanonical
+    }
+    return n, nil
+}
+
+// bigInt decodes a string item as a big-endian integer of at most maxBytes
+// bytes, without leading zeros.
+func (item rlpItem) bigInt(maxBytes int) (*big.Int, error) {
+    if item.list || len(item.data) > maxBytes {
+        return nil, errRLPMalformed
+    }
+    if len(item.data) > 0 && item.data[0] == 0 {
+        return nil, errRLPNonCanonical
+    }
+    return new(big.Int).SetBytes(item.data), nil
+}
+
+func encodeRLPString(data []byte) []byte {
+    if len(data) == 1 && data[0] < 0x80 {
+        return data
+    }
+    return append(rlpHeader(0x80, len(data)), data...)
+}
+
+func encodeRLPUint(n *big.Int) []byte {
+    return encodeRLPString(n.Bytes())
+}
+
+// encodeRLPList wraps already encoded items into a list.
+func encodeRLPList(items ...[]byte) []byte {
+    var content []byte
+    for _, item := range items {
+        content = append(content, item...)
+    }
+    return append(rlpHeader(0xc0, len(content)), content...)
+}
+
+func rlpHeader(base byte, size int) []byte {
+    if size < 56 {
+        return []byte{base + byte(size)}
+    }
+
+    var length []byte
+    for n := size; n > 0; n >>= 8 {
+        length = append([]byte{byte(n)}, length...)
+    }
+    return append([]byte{base + 55 + byte(len(length))}, length...)
+}
\ No newline at end of file
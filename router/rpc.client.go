@@ -0,0 +1,336 @@
+package router
+
+import (
+    "context"
+    "encoding/json"
+    "bytes"
+    "io/ioutil"
+    "net/http"
+    "net/url"
+    "strings"
+)
+
+const defaultMaxBatchSize int = 100
+
+// RPCClientConfig describes a single upstream node.
+type RPCClientConfig struct {
+    Url string `json:"url"`
+    MaxBatchSize int `json:"maxBatchSize,omitempty"`
+    // Auth is applied to HTTP requests and WebSocket handshakes; IPC
+    // sockets are not authenticated.
+    Auth UpstreamAuth `json:"auth,omitempty"`
+    Limit LimitConfig `json:"limit,omitempty"`
+}
+
+// RPCClient sends JSON-RPC requests to the node and returns the raw response
+// bodies. The context is honoured for cancellation and deadlines.
+//
+// Clients assign a unique id to every request they send and check that the
+// response carries it back. CallBatch returns one response per request, in
+// request order.
+type RPCClient interface {
+    Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error)
+    CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error)
+}
+
+// NewRPCClient picks the transport for the upstream from the scheme of its
+// url, behind a LimitedRPCClient when limits are configured.
+func NewRPCClient(config RPCClientConfig) (RPCClient, error) {
+    client, err := newTransportRPCClient(config)
+    if err != nil {
+        return nil, err
+    }
+
+    if config.Limit.isEmpty() {
+        return client, nil
+    }
+
+    return NewLimitedRPCClient(config.Url, client, config.Limit), nil
+}
+
+// redactUrl shortens an upstream url to its scheme and host for logs,
+// metrics and status, dropping the user info, path and query that may hold
+// credentials or API keys. IPC paths are kept.
+func redactUrl(rawUrl string) string {
+    upstreamUrl, err := url.Parse(rawUrl)
+    if err != nil || upstreamUrl.Scheme == "" {
+        return "upstream"
+    }
+    if upstreamUrl.Host == "" {
+        return upstreamUrl.Scheme + "://" + upstreamUrl.Path
+    }
+
+    redacted := upstreamUrl.Scheme + "://" + upstreamUrl.Host
+    if upstreamUrl.User != nil || strings.Trim(upstreamUrl.Path, "/") != "" || upstreamUrl.RawQuery != "" {
+        redacted += "/..."
+    }
+    return redacted
+}
+
+func newTransportRPCClient(config RPCClientConfig) (RPCClient, error) {
+    upstreamUrl, err := url.Parse(config.Url)
+    if err != nil {
+        return nil, ErrInvalidUpstreamUrl
+    }
+
+    var auth *Authenticator
+    if !config.Auth.isEmpty() {
+        auth, err = NewAuthenticator(config.Auth)
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    switch upstreamUrl.Scheme {
+    case "http", "https":
+        client := NewHTTPRPCClient(config.Url)
+        client.Auth = auth
+        if config.MaxBatchSize > 0 {
+            client.MaxBatchSize = config.MaxBatchSize
+        }
+        return client, nil
+    case "ws", "wss":
+        client := NewWSRPCClient(config.Url, auth)
+        if config.MaxBatchSize > 0 {
+            client.MaxBatchSize = config.MaxBatchSize
+        }
+        return client, nil
+    case "ipc":
+        socketPath := upstreamUrl.Host + upstreamUrl.Path
+        if socketPath == "" {
+            return nil, ErrInvalidUpstreamUrl
+        }
+        client := NewIPCRPCClient(socketPath)
+        if config.MaxBatchSize > 0 {
+            client.MaxBatchSize = config.MaxBatchSize
+        }
+        return client, nil
+    }
+
+    return nil, ErrInvalidUpstreamUrl
+}
+
+// splitBatch cuts rpcReqs into consecutive chunks of at most batchSize
+// requests.
+func splitBatch(rpcReqs []EthRPCRequest, batchSize int) [][]EthRPCRequest {
+    if batchSize <= 0 {
+        batchSize = defaultMaxBatchSize
+    }
+
+    batches := [][]EthRPCRequest{}
+    for start := 0; start < len(rpcReqs); start += batchSize {
+        end := start + batchSize
+        if end > len(rpcReqs) {
+            end = len(rpcReqs)
+        }
+        batches = append(batches, rpcReqs[start:end])
+    }
+
+    return batches
+}
+
+// rpcClientWrapper is implemented by clients that decorate another client.
+type rpcClientWrapper interface {
+    Unwrap() RPCClient
+}
+
+// findStatusReporter walks down a chain of wrapping clients to the first one
+// that reports upstream status.
+func findStatusReporter(client RPCClient) (RPCStatusReporter, bool) {
+    for client != nil {
+        if reporter, ok := client.(RPCStatusReporter); ok {
+            return reporter, true
+        }
+
+        wrapper, ok := client.(rpcClientWrapper)
+        if !ok {
+            break
+        }
+        client = wrapper.Unwrap()
+    }
+
+    return nil, false
+}
+
+// findSubscriber walks down a chain of wrapping clients to the transport
+// that can push subscriptions.
+func findSubscriber(client RPCClient) (RPCSubscriber, bool) {
+    for client != nil {
+        if subscriber, ok := client.(RPCSubscriber); ok {
+            return subscriber, true
+        }
+
+        wrapper, ok := client.(rpcClientWrapper)
+        if !ok {
+            break
+        }
+        client = wrapper.Unwrap()
+    }
+
+    return nil, false
+}
+
+// checkResponseId verifies that resp answers the request with id. Errors the
+// node could not attribute to a request carry a null id and are let through.
+func checkResponseId(id int32, resp []byte) error {
+    var respId rpcResponse
+    err := json.Unmarshal(resp, &respId)
+    if err != nil {
+        return ErrParsingJSON
+    }
+
+    if respId.Id == nil && respId.Error != nil {
+        return nil
+    }
+
+    if respId.Id == nil || *respId.Id != id {
+        return ErrResponseIdMismatch
+    }
+
+    return nil
+}
+
+// assignRPCIds returns a copy of rpcReqs with fresh unique ids.
+func assignRPCIds(rpcReqs []EthRPCRequest) []EthRPCRequest {
+    wireReqs := make([]EthRPCRequest, len(rpcReqs))
+    for i, rpcReq := range rpcReqs {
+        rpcReq.Id = nextRPCId()
+        wireReqs[i] = rpcReq
+    }
+
+    return wireReqs
+}
+
+// matchBatchResponse orders the elements of a JSON-RPC batch response by the
+// ids of the requests that produced them.
+func matchBatchResponse(rpcReqs []EthRPCRequest, respBytes []byte) ([][]byte, error) {
+    var rawResps []json.RawMessage
+    err := json.Unmarshal(respBytes, &rawResps)
+    if err != nil {
+        return nil, ErrParsingJSON
+    }
+
+    respById := map[int32][]byte{}
+    for _, rawResp := range rawResps {
+        var respId rpcResponse
+        err = json.Unmarshal(rawResp, &respId)
+        if err != nil {
+            return nil, ErrParsingJSON
+        }
+        if respId.Id == nil {
+            return nil, ErrBatchMismatch
+        }
+        respById[*respId.Id] = rawResp
+    }
+
+    resps := make([][]byte, len(rpcReqs))
+    for i, rpcReq := range rpcReqs {
+        resp, ok := respById[rpcReq.Id]
+        if !ok {
+            return nil, ErrBatchMismatch
+        }
+        resps[i] = resp
+    }
+
+    return resps, nil
+}
+
+/* ----- HTTP TRANSPORT ----- */
+type HTTPRPCClient struct {
+    Url string
+    Client *http.Client
+    // MaxBatchSize caps the number of requests sent in one batch POST;
+    // larger batches are split.
+    MaxBatchSize int
+    // Auth, when set, authenticates every request.
+    Auth *Authenticator
+}
+
+func NewHTTPRPCClient(url string) *HTTPRPCClient {
+    return &HTTPRPCClient{
+        Url: url,
+        Client: &http.Client{},
+        MaxBatchSize: defaultMaxBatchSize,
+    }
+}
+
+func (c *HTTPRPCClient) Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    rpcReq.Id = nextRPCId()
+
+    jsonData, err := json.Marshal(rpcReq)
+    if err != nil {
+        return nil, ErrEncodingJSON
+    }
+
+    resp, err := c.post(ctx, jsonData)
+    if err != nil {
+        return nil, err
+    }
+
+    err = checkResponseId(rpcReq.Id, resp)
+    if err != nil {
+        return nil, err
+    }
+
+    return resp, nil
+}
+
+func (c *HTTPRPCClient) CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error) {
+    resps := make([][]byte, 0, len(rpcReqs))
+    for _, batch := range splitBatch(assignRPCIds(rpcReqs), c.MaxBatchSize) {
+        jsonData, err := json.Marshal(batch)
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        respBytes, err := c.post(ctx, jsonData)
+        if err != nil {
+            return nil, err
+        }
+
+        batchResps, err := matchBatchResponse(batch, respBytes)
+        if err != nil {
+            return nil, err
+        }
+        resps = append(resps, batchResps...)
+    }
+
+    return resps, nil
+}
+
+func (c *HTTPRPCClient) post(ctx context.Context, jsonData []byte) ([]byte, error) {
+    logUpstream(ctx, "Sending GethRPC request on address \"" + c.Url + "\" with payload: " + string(jsonData))
+
+    req, err := http.NewRequest("POST", c.Url, bytes.NewBuffer(jsonData))
+    if err != nil {
+        return nil, ErrConnectingToGeth
+    }
+    if c.Auth != nil {
+        c.Auth.apply(req)
+    }
+    req.Header.Set("Content-Type", "application/json")
+    if requestId := RequestIdFromContext(ctx); requestId != "" {
+        req.Header.Set(RequestIdHeader, requestId)
+    }
+
+    resp, err := c.Client.Do(req.WithContext(ctx))
+    if err != nil {
+        if ctx.Err() != nil {
+            return nil, ctx.Err()
+        }
+        return nil, ErrConnectingToGeth
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, &HTTPStatusError{resp.StatusCode}
+    }
+
+    respBytes, err := ioutil.ReadAll(resp.Body)
+    if err != nil {
+        return nil, ErrReadingGethResponse
+    }
+
+    logUpstream(ctx, "Got response from GethRPC with JSON payload: ", string(respBytes))
+    return respBytes, nil
+}
\ No newline at end of file
@@ -0,0 +1,150 @@
+package router
+
+import (
+    "errors"
+    "math/big"
+)
+
+var errInvalidSignature = errors.New("invalid signature")
+
+// secp256k1 domain parameters. crypto/elliptic only implements curves with
+// a = -3, secp256k1 has a = 0 and b = 7.
+var (
+    secp256k1P, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+    secp256k1N, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+    secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+    secp256k1G = curvePoint{
+        x: hexBigInt("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+        y: hexBigInt("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+    }
+    // secp256k1SqrtExp is (p + 1) / 4, square roots mod p being powers of
+    // it as p = 3 mod 4.
+    secp256k1SqrtExp = new(big.Int).Rsh(new(big.Int).Add(secp256k1P, big.NewInt(1)), 2)
+)
+
+// curvePoint is an affine point of secp256k1, the point at infinity having
+// a nil x.
+type curvePoint struct {
+    x *big.Int
+    y *big.Int
+}
+
+func (p curvePoint) infinity() bool {
+    return p.x == nil
+}
+
+// add returns p + q. Signature recovery only handles public data, so the
+// arithmetic need not run in constant time.
+func (p curvePoint) add(q curvePoint) curvePoint {
+    if p.infinity() {
+        return q
+    }
+    if q.infinity() {
+        return p
+    }
+
+    var lambda *big.Int
+    if p.x.Cmp(q.x) == 0 {
+        if p.y.Cmp(q.y) != 0 || p.y.Sign() == 0 {
+            return curvePoint{}
+        }
+        // lambda = 3x² / 2y
+        lambda = new(big.Int).Mul(p.x, p.x)
+        lambda.Mul(lambda, big.NewInt(3))
+        lambda.Mul(lambda, modInverse(new(big.Int).Lsh(p.y, 1), secp256k1P))
+    } else {
+        // lambda = (y2 - y1) / (x2 - x1)
+        lambda = new(big.Int).Sub(q.y, p.y)
+        lambda.Mul(lambda, modInverse(new(big.Int).Sub(q.x, p.x), secp256k1P))
+    }
+    lambda.Mod(lambda, secp256k1P)
+
+    x := new(big.Int).Mul(lambda, lambda)
+    x.Sub(x, p.x)
+    x.Sub(x, q.x)
+    x.Mod(x, secp256k1P)
+
+    y := new(big.Int).Sub(p.x, x)
+    y.Mul(y, lambda)
+    y.Sub(y, p.y)
+    y.Mod(y, secp256k1P)
+
+    return curvePoint{x, y}
+}
+
+// mul returns k * p by double and add.
+func (p curvePoint) mul(k *big.Int) curvePoint {
+    result := curvePoint{}
+    for i := k.BitLen() - 1; i >= 0; i-- {
+        result = result.add(result)
+        if k.Bit(i) == 1 {
+            result = result.add(p)
+        }
+    }
+    return result
+}
+
+// recoverPublicKey returns the uncompressed public key, without its 0x04
+// prefix, whose key signed hash with (r, s). yParity is the parity of the y
+// coordinate of the signature's R point.
+func recoverPublicKey(hash []byte, r *big.Int, s *big.Int, yParity uint) ([]byte, error) {
+    if r.Sign() <= 0 || r.Cmp(secp256k1N) >= 0 || s.Sign() <= 0 || s.Cmp(secp256k1N) >= 0 || yParity > 1 {
+        return nil, errInvalidSignature
+    }
+
+    // R = (r, y) with y² = r³ + 7
+    alpha := new(big.Int).Exp(r, big.NewInt(3), secp256k1P)
+    alpha.Add(alpha, big.NewInt(7))
+    alpha.Mod(alpha, secp256k1P)
+
+    y := new(big.Int).Exp(alpha, secp256k1SqrtExp, secp256k1P)
+    if new(big.Int).Exp(y, big.NewInt(2), secp256k1P).Cmp(alpha) != 0 {
+        return nil, errInvalidSignature
+    }
+    if y.Bit(0) != yParity {
+        y.Sub(secp256k1P, y)
+    }
+    point := curvePoint{new(big.Int).Set(r), y}
+
+    // Q = r⁻¹ (sR - eG)
+    rInverse := modInverse(r, secp256k1N)
+    e := new(big.Int).SetBytes(hash)
+
+    u1 := new(big.Int).Neg(e)
+    u1.Mul(u1, rInverse)
+    u1.Mod(u1, secp256k1N)
+
+    u2 := new(big.Int).Mul(s, rInverse)
+    u2.Mod(u2, secp256k1N)
+
+    q := secp256k1G.mul(u1).add(point.mul(u2))
+    if q.infinity() {
+        return nil, errInvalidSignature
+    }
+
+    return append(leftPad32(q.x.Bytes()), leftPad32(q.y.Bytes())...), nil
+}
+
+// recoverAddress returns the address of the key that signed hash.
+// Signatures with s in the upper half of the curve order are malleable and
+// refused, as they are since Homestead.
+func recoverAddress(hash []byte, r *big.Int, s *big.Int, yParity uint) ([]byte, error) {
+    if s.Cmp(secp256k1HalfN) > 0 {
+        return nil, errInvalidSignature
+    }
+
+    publicKey, err := recoverPublicKey(hash, r, s, yParity)
+    if err != nil {
+        return nil, err
+    }
+    return keccak256(publicKey)[12:], nil
+}
+
+func modInverse(n *big.Int, m *big.Int) *big.Int {
+    return new(big.Int).ModInverse(new(big.Int).Mod(n, m), m)
+}
+
+func hexBigInt(s string) *big.Int {
+    n, _ := new(big.Int).SetString(s, 16)
+    return n
+}
\ No newline at end of file
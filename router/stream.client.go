@@ -0,0 +1,533 @@
+package router
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "log"
+    "sync"
+    "time"
+)
+
+const defaultReconnectDelay time.Duration = 1 * time.Second
+const maxReconnectDelay time.Duration = 30 * time.Second
+const notificationBuffer int = 256
+// streamDialTimeout bounds a dial, which is shared by every caller waiting
+// for the connection. streamWriteTimeout bounds every write.
+const streamDialTimeout time.Duration = 10 * time.Second
+const streamWriteTimeout time.Duration = 10 * time.Second
+
+// RPCSubscriber is implemented by upstream clients that can push
+// eth_subscribe notifications.
+type RPCSubscriber interface {
+    Subscribe(ctx context.Context, kind string, filter interface{}) (*Subscription, error)
+    Unsubscribe(ctx context.Context, sub *Subscription) error
+}
+
+// Subscription delivers the "result" of every eth_subscription notification
+// for one subscription. Notifications is closed on Unsubscribe or when the
+// client is closed. The subscription survives reconnects.
+type Subscription struct {
+    Kind string
+    Notifications chan json.RawMessage
+
+    filter interface{}
+    id string
+    closed bool
+}
+
+type streamMessage struct {
+    Id *int32 `json:"id"`
+    Error *RPCError `json:"error"`
+    Method string `json:"method"`
+    Params struct {
+        Subscription string `json:"subscription"`
+        Result json.RawMessage `json:"result"`
+    } `json:"params"`
+}
+
+type streamCallResult struct {
+    resp []byte
+    err error
+}
+
+// streamConn is a persistent, message-oriented connection to the node.
+// Writes are serialized by the client and fail after streamWriteTimeout;
+// reads happen on a single goroutine.
+type streamConn interface {
+    ReadMessage() ([]byte, error)
+    WriteMessage(msg []byte) error
+    Close() error
+}
+
+type streamDialer func(ctx context.Context) (streamConn, error)
+
+// streamDial is a dial in flight. done is closed once conn or err is set.
+type streamDial struct {
+    done chan struct{}
+    conn streamConn
+    err error
+}
+
+/* ----- STREAM TRANSPORT ----- */
+
+// StreamRPCClient multiplexes JSON-RPC calls and subscriptions over a single
+// persistent connection (WebSocket or IPC). The connection is dialed lazily
+// and re-established after failures; active subscriptions are renewed on
+// reconnect.
+type StreamRPCClient struct {
+    Url string
+    MaxBatchSize int
+    ReconnectDelay time.Duration
+
+    dial streamDialer
+
+    mu sync.Mutex
+    conn streamConn
+    dialing *streamDial
+    pending map[int32]chan streamCallResult
+    pendingSubs map[int32]*Subscription
+    subs map[string]*Subscription
+    reconnecting bool
+    closed bool
+
+    writeMu sync.Mutex
+}
+
+func newStreamRPCClient(url string, dial streamDialer) *StreamRPCClient {
+    return &StreamRPCClient{
+        Url: url,
+        MaxBatchSize: defaultMaxBatchSize,
+        ReconnectDelay: defaultReconnectDelay,
+        dial: dial,
+        pending: map[int32]chan streamCallResult{},
+        pendingSubs: map[int32]*Subscription{},
+        subs: map[string]*Subscription{},
+    }
+}
+
+func (c *StreamRPCClient) Call(ctx context.Context, rpcReq EthRPCRequest) ([]byte, error) {
+    rpcReq.Id = nextRPCId()
+
+    jsonData, err := json.Marshal(rpcReq)
+    if err != nil {
+        return nil, ErrEncodingJSON
+    }
+
+    resps, err := c.roundTrip(ctx, []int32{rpcReq.Id}, jsonData)
+    if err != nil {
+        return nil, err
+    }
+
+    return resps[0], nil
+}
+
+func (c *StreamRPCClient) CallBatch(ctx context.Context, rpcReqs []EthRPCRequest) ([][]byte, error) {
+    resps := make([][]byte, 0, len(rpcReqs))
+    for _, batch := range splitBatch(assignRPCIds(rpcReqs), c.MaxBatchSize) {
+        ids := make([]int32, len(batch))
+        for i, rpcReq := range batch {
+            ids[i] = rpcReq.Id
+        }
+
+        jsonData, err := json.Marshal(batch)
+        if err != nil {
+            return nil, ErrEncodingJSON
+        }
+
+        batchResps, err := c.roundTrip(ctx, ids, jsonData)
+        if err != nil {
+            return nil, err
+        }
+        resps = append(resps, batchResps...)
+    }
+
+    return resps, nil
+}
+
+func (c *StreamRPCClient) Subscribe(ctx context.Context, kind string, filter interface{}) (*Subscription, error) {
+    switch kind {
+    case "newHeads", "newPendingTransactions":
+        filter = nil
+    case "logs":
+    default:
+        return nil, ErrUnsupportedSubscription
+    }
+
+    sub := &Subscription{
+        Kind: kind,
+        Notifications: make(chan json.RawMessage, notificationBuffer),
+        filter: filter,
+    }
+
+    err := c.subscribe(ctx, sub)
+    if err != nil {
+        return nil, err
+    }
+
+    return sub, nil
+}
+
+func (c *StreamRPCClient) Unsubscribe(ctx context.Context, sub *Subscription) error {
+    c.mu.Lock()
+    if sub.closed {
+        c.mu.Unlock()
+        return nil
+    }
+    id := sub.id
+    delete(c.subs, id)
+    sub.closed = true
+    close(sub.Notifications)
+    c.mu.Unlock()
+
+    rpcReq := EthRPCRequest{Id: nextRPCId()}
+    rpcReq.construct("eth_unsubscribe", id)
+    jsonData, err := json.Marshal(rpcReq)
+    if err != nil {
+        return ErrEncodingJSON
+    }
+
+    resps, err := c.roundTrip(ctx, []int32{rpcReq.Id}, jsonData)
+    if err != nil {
+        return err
+    }
+
+    var unsubscribed bool
+    return decodeRPCResult(resps[0], &unsubscribed)
+}
+
+// Close tears down the connection, fails pending calls and closes every
+// subscription channel.
+func (c *StreamRPCClient) Close() error {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    c.closed = true
+    for id, sub := range c.subs {
+        sub.closed = true
+        close(sub.Notifications)
+        delete(c.subs, id)
+    }
+
+    if c.conn != nil {
+        return c.conn.Close()
+    }
+    return nil
+}
+
+// subscribe issues eth_subscribe for sub. The subscription is keyed under
+// its new id by dispatch, before any notification for it can be read.
+func (c *StreamRPCClient) subscribe(ctx context.Context, sub *Subscription) error {
+    rpcReq := EthRPCRequest{Id: nextRPCId()}
+    if sub.filter != nil {
+        rpcReq.construct("eth_subscribe", sub.Kind, sub.filter)
+    } else {
+        rpcReq.construct("eth_subscribe", sub.Kind)
+    }
+    jsonData, err := json.Marshal(rpcReq)
+    if err != nil {
+        return ErrEncodingJSON
+    }
+
+    c.mu.Lock()
+    c.pendingSubs[rpcReq.Id] = sub
+    c.mu.Unlock()
+
+    defer func() {
+        c.mu.Lock()
+        delete(c.pendingSubs, rpcReq.Id)
+        c.mu.Unlock()
+    }()
+
+    resps, err := c.roundTrip(ctx, []int32{rpcReq.Id}, jsonData)
+    if err != nil {
+        return err
+    }
+
+    var subId string
+    err = decodeRPCResult(resps[0], &subId)
+    if err == nil && subId == "" {
+        return ErrNullResult
+    }
+
+    return err
+}
+
+// roundTrip writes payload and waits for a response to every id in ids.
+func (c *StreamRPCClient) roundTrip(ctx context.Context, ids []int32, payload []byte) ([][]byte, error) {
+    conn, err := c.connect(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    results := make([]chan streamCallResult, len(ids))
+    c.mu.Lock()
+    for i, id := range ids {
+        results[i] = make(chan streamCallResult, 1)
+        c.pending[id] = results[i]
+    }
+    c.mu.Unlock()
+
+    defer func() {
+        c.mu.Lock()
+        for _, id := range ids {
+            delete(c.pending, id)
+        }
+        c.mu.Unlock()
+    }()
+
+    logUpstream(ctx, "Sending GethRPC request on address \"" + c.Url + "\" with payload: " + string(payload))
+
+    c.writeMu.Lock()
+    err = conn.WriteMessage(payload)
+    c.writeMu.Unlock()
+    if err != nil {
+        conn.Close()
+        return nil, ErrConnectingToGeth
+    }
+
+    resps := make([][]byte, len(ids))
+    for i := range ids {
+        select {
+        case result := <-results[i]:
+            if result.err != nil {
+                return nil, result.err
+            }
+            logUpstream(ctx, "Got response from GethRPC with JSON payload: ", string(result.resp))
+            resps[i] = result.resp
+        case <-ctx.Done():
+            return nil, ctx.Err()
+        }
+    }
+
+    return resps, nil
+}
+
+// connect returns the connection, dialing it if there is none. Callers
+// arriving during a dial wait for it rather than starting their own, until
+// their own context ends.
+func (c *StreamRPCClient) connect(ctx context.Context) (streamConn, error) {
+    c.mu.Lock()
+    if c.closed {
+        c.mu.Unlock()
+        return nil, ErrConnectingToGeth
+    }
+
+    if c.conn != nil {
+        conn := c.conn
+        c.mu.Unlock()
+        return conn, nil
+    }
+
+    dial := c.dialing
+    if dial == nil {
+        dial = &streamDial{done: make(chan struct{})}
+        c.dialing = dial
+        go c.runDial(dial)
+    }
+    c.mu.Unlock()
+
+    select {
+    case <-dial.done:
+    case <-ctx.Done():
+        return nil, ctx.Err()
+    }
+
+    if dial.err != nil {
+        return nil, ErrConnectingToGeth
+    }
+    return dial.conn, nil
+}
+
+func (c *StreamRPCClient) runDial(dial *streamDial) {
+    ctx, cancel := context.WithTimeout(context.Background(), streamDialTimeout)
+    conn, err := c.dial(ctx)
+    cancel()
+
+    c.mu.Lock()
+    c.dialing = nil
+    if err == nil && c.closed {
+        conn.Close()
+        err = ErrConnectingToGeth
+    }
+
+    if err == nil {
+        log.Println("Connected to GethRPC on address \"" + c.Url + "\"")
+
+        c.conn = conn
+        go c.readLoop(conn)
+    }
+    dial.conn, dial.err = conn, err
+    c.mu.Unlock()
+
+    close(dial.done)
+}
+
+func (c *StreamRPCClient) readLoop(conn streamConn) {
+    for {
+        msg, err := conn.ReadMessage()
+        if err != nil {
+            log.Println("Lost connection to GethRPC on address \"" + c.Url + "\": " + err.Error())
+            c.dropConn(conn)
+            return
+        }
+
+        c.dispatch(msg)
+    }
+}
+
+func (c *StreamRPCClient) dispatch(msg []byte) {
+    msg = bytes.TrimSpace(msg)
+    if len(msg) > 0 && msg[0] == '[' {
+        var rawMsgs []json.RawMessage
+        if json.Unmarshal(msg, &rawMsgs) != nil {
+            log.Println("Dropping unparseable GethRPC message: ", string(msg))
+            return
+        }
+
+        for _, rawMsg := range rawMsgs {
+            c.dispatch(rawMsg)
+        }
+        return
+    }
+
+    var streamMsg streamMessage
+    if json.Unmarshal(msg, &streamMsg) != nil {
+        log.Println("Dropping unparseable GethRPC message: ", string(msg))
+        return
+    }
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    if streamMsg.Method == "eth_subscription" {
+        sub, ok := c.subs[streamMsg.Params.Subscription]
+        if !ok {
+            return
+        }
+
+        select {
+        case sub.Notifications <- streamMsg.Params.Result:
+        default:
+            log.Println("Dropping " + sub.Kind + " notification, subscriber is not keeping up")
+        }
+        return
+    }
+
+    if streamMsg.Id == nil {
+        // Errors the node could not tie to a request, such as parse errors,
+        // carry a null id. Any call waiting on the connection may be the one
+        // it is about, so all of them get it, as they would over HTTP.
+        if streamMsg.Error != nil {
+            log.Println("GethRPC answered with an error for no request, failing pending calls: " + streamMsg.Error.Error())
+            for id, result := range c.pending {
+                result <- streamCallResult{msg, nil}
+                delete(c.pending, id)
+            }
+        }
+        return
+    }
+
+    if sub, ok := c.pendingSubs[*streamMsg.Id]; ok && !sub.closed {
+        var subId string
+        if decodeRPCResult(msg, &subId) == nil && subId != "" {
+            if c.subs[sub.id] == sub {
+                delete(c.subs, sub.id)
+            }
+            sub.id = subId
+            c.subs[sub.id] = sub
+        }
+    }
+
+    if result, ok := c.pending[*streamMsg.Id]; ok {
+        result <- streamCallResult{msg, nil}
+        delete(c.pending, *streamMsg.Id)
+    }
+}
+
+// dropConn fails every in-flight call on conn and, if subscriptions are
+// active, starts reconnecting in the background.
+func (c *StreamRPCClient) dropConn(conn streamConn) {
+    conn.Close()
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    if c.conn != conn {
+        return
+    }
+    c.conn = nil
+
+    for id, result := range c.pending {
+        result <- streamCallResult{nil, ErrConnectingToGeth}
+        delete(c.pending, id)
+    }
+
+    if !c.closed && !c.reconnecting && len(c.subs) > 0 {
+        c.reconnecting = true
+        go c.reconnect()
+    }
+}
+
+func (c *StreamRPCClient) reconnect() {
+    delay := c.ReconnectDelay
+    if delay <= 0 {
+        delay = defaultReconnectDelay
+    }
+
+    for {
+        time.Sleep(delay)
+
+        ctx, cancel := context.WithTimeout(context.Background(), maxReconnectDelay)
+        err := c.resubscribe(ctx)
+        cancel()
+
+        if err == nil {
+            c.mu.Lock()
+            c.reconnecting = false
+            c.mu.Unlock()
+            return
+        }
+
+        c.mu.Lock()
+        if c.closed {
+            c.reconnecting = false
+            c.mu.Unlock()
+            return
+        }
+        c.mu.Unlock()
+
+        log.Println("Reconnecting to GethRPC on address \"" + c.Url + "\" failed: " + err.Error())
+
+        delay *= 2
+        if delay > maxReconnectDelay {
+            delay = maxReconnectDelay
+        }
+    }
+}
+
+// resubscribe re-issues eth_subscribe for every active subscription on a
+// fresh connection. Subscription ids are node-local, so each one is
+// re-keyed under the id the node hands back.
+func (c *StreamRPCClient) resubscribe(ctx context.Context) error {
+    _, err := c.connect(ctx)
+    if err != nil {
+        return err
+    }
+
+    c.mu.Lock()
+    subs := make([]*Subscription, 0, len(c.subs))
+    for _, sub := range c.subs {
+        subs = append(subs, sub)
+    }
+    c.mu.Unlock()
+
+    for _, sub := range subs {
+        err = c.subscribe(ctx, sub)
+        if err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
\ No newline at end of file
@@ -0,0 +1,105 @@
+package router
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+)
+
+// pipeConn is a streamConn whose messages are read from in and written to
+// out.
+type pipeConn struct {
+    in chan []byte
+    out chan []byte
+    closed chan struct{}
+}
+
+func newPipeConn() *pipeConn {
+    return &pipeConn{in: make(chan []byte, 16), out: make(chan []byte, 16), closed: make(chan struct{})}
+}
+
+func (c *pipeConn) ReadMessage() ([]byte, error) {
+    select {
+    case msg := <-c.in:
+        return msg, nil
+    case <-c.closed:
+        return nil, errors.New("closed")
+    }
+}
+
+func (c *pipeConn) WriteMessage(msg []byte) error {
+    c.out <- msg
+    return nil
+}
+
+func (c *pipeConn) Close() error {
+    select {
+    case <-c.closed:
+    default:
+        close(c.closed)
+    }
+    return nil
+}
+
+func TestStreamCallsFailOnErrorsWithoutId(t *testing.T) {
+    conn := newPipeConn()
+    client := newStreamRPCClient("test", func(ctx context.Context) (streamConn, error) {
+        return conn, nil
+    })
+    defer client.Close()
+
+    ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+    defer cancel()
+
+    done := make(chan error, 1)
+    go func() {
+        rpcReq := EthRPCRequest{}
+        rpcReq.constructGetBlockNumberRequest()
+        resp, err := client.Call(ctx, rpcReq)
+        if err == nil {
+            var result string
+            err = decodeRPCResult(resp, &result)
+        }
+        done <- err
+    }()
+
+    <-conn.out
+    conn.in <- []byte(`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`)
+
+    err := <-done
+    if rpcErr, ok := err.(*RPCError); !ok || rpcErr.Code != -32700 {
+        t.Errorf("got %v, want the node's parse error", err)
+    }
+}
+
+func TestStreamCallersWaitingForADialHonourTheirContext(t *testing.T) {
+    conn := newPipeConn()
+    release := make(chan struct{})
+    dials := 0
+    client := newStreamRPCClient("test", func(ctx context.Context) (streamConn, error) {
+        dials++
+        <-release
+        return conn, nil
+    })
+    defer client.Close()
+
+    for i := 0; i < 2; i++ {
+        ctx, cancel := context.WithTimeout(context.Background(), 20 * time.Millisecond)
+        started := time.Now()
+        _, err := client.connect(ctx)
+        cancel()
+        if err != context.DeadlineExceeded || time.Since(started) > time.Second {
+            t.Fatalf("got %v after %s, want the caller's deadline", err, time.Since(started))
+        }
+    }
+
+    close(release)
+    got, err := client.connect(context.Background())
+    if err != nil || got != conn {
+        t.Fatalf("got %v, %v, want the dialed connection", got, err)
+    }
+    if dials != 1 {
+        t.Errorf("got %d dials, want callers to share one", dials)
+    }
+}
\ No newline at end of file
@@ -0,0 +1,461 @@
+package router
+
+import (
+    "context"
+    "encoding/hex"
+    "math/big"
+    "strings"
+    "sync"
+    "unicode/utf8"
+)
+
+// DefaultTokenCacheSize is how many tokens have their metadata cached.
+const DefaultTokenCacheSize int = 10000
+
+// ERC-20 function selectors.
+const (
+    erc20Name = "06fdde03"
+    erc20Symbol = "95d89b41"
+    erc20Decimals = "313ce567"
+    erc20BalanceOf = "70a08231"
+    erc20Transfer = "a9059cbb"
+    erc20TransferFrom = "23b872dd"
+    erc20Approve = "095ea7b3"
+)
+
+// ERC-20 event topics, Transfer(address,address,uint256) and
+// Approval(address,address,uint256).
+const (
+    TransferEventTopic string = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+    ApprovalEventTopic string = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+)
+
+// Kinds of TokenMovement.
+const (
+    TokenTransfer string = "transfer"
+    TokenApproval string = "approval"
+)
+
+// Sources of TokenMovement.
+const (
+    TokenSourceCalldata string = "calldata"
+    TokenSourceLog string = "log"
+)
+
+// TokenMetadata is what an ERC-20 token tells about itself. Each field is
+// optional in the standard; Decimals is nil when the token does not say.
+type TokenMetadata struct {
+    Name string `json:"name,omitempty"`
+    Symbol string `json:"symbol,omitempty"`
+    Decimals *int `json:"decimals,omitempty"`
+}
+
+// TokenMovement is an ERC-20 transfer or approval, decoded from the
+// calldata of a transaction or from one of its logs. Approvals go From the
+// owner To the spender. Spender is the caller of transferFrom. Amount is in
+// the smallest unit of the token, DisplayAmount the same with the token's
+// decimals when it reports them.
+type TokenMovement struct {
+    Kind string `json:"kind"`
+    Source string `json:"source"`
+    Token string `json:"token"`
+    From string `json:"from"`
+    To string `json:"to"`
+    Spender string `json:"spender,omitempty"`
+    Amount string `json:"amount"`
+    DisplayAmount string `json:"displayAmount,omitempty"`
+    Symbol string `json:"symbol,omitempty"`
+    Decimals *int `json:"decimals,omitempty"`
+    LogIndex string `json:"logIndex,omitempty"`
+}
+
+// TokenBalance is the token balance of Address at Block, rendered like
+// TokenMovement amounts.
+type TokenBalance struct {
+    Token string `json:"token"`
+    Address string `json:"address"`
+    Block string `json:"block"`
+    Balance string `json:"balance"`
+    DisplayBalance string `json:"displayBalance,omitempty"`
+    TokenMetadata
+}
+
+type GetTokenBalanceRequest struct {
+    Token string
+    Address string
+    Block string
+}
+
+/* ----- METADATA CACHE ----- */
+
+// TokenCache keeps the metadata of up to MaxTokens tokens, which does not
+// change once a token is deployed. A full cache drops an arbitrary token.
+type TokenCache struct {
+    MaxTokens int
+
+    mu sync.Mutex
+    tokens map[string]TokenMetadata
+}
+
+func NewTokenCache(maxTokens int) *TokenCache {
+    return &TokenCache{MaxTokens: maxTokens, tokens: map[string]TokenMetadata{}}
+}
+
+func (cache *TokenCache) get(token string) (TokenMetadata, bool) {
+    cache.mu.Lock()
+    defer cache.mu.Unlock()
+
+    metadata, ok := cache.tokens[token]
+    return metadata, ok
+}
+
+func (cache *TokenCache) put(token string, metadata TokenMetadata) {
+    cache.mu.Lock()
+    defer cache.mu.Unlock()
+
+    if cache.MaxTokens <= 0 {
+        return
+    }
+    for cached := range cache.tokens {
+        if len(cache.tokens) < cache.MaxTokens {
+            break
+        }
+        delete(cache.tokens, cached)
+    }
+    cache.tokens[token] = metadata
+}
+
+/* ----- SERVICE ----- */
+
+// GetTokenBalance calls balanceOf(address) on the token at block, latest
+// when empty.
+func (svc EthServiceImp) GetTokenBalance(ctx context.Context, req GetTokenBalanceRequest) (interface{}, error) {
+    if !isHexAddress(req.Token) || !isHexAddress(req.Address) {
+        return nil, ErrInvalidAddress
+    }
+    token := strings.ToLower(req.Token)
+
+    blockParam, err := normalizeBlockParam(req.Block)
+    if err != nil {
+        return nil, err
+    }
+
+    blockLabel, ok := blockParam.(string)
+    if !ok {
+        blockLabel = req.Block
+    }
+
+    rpcReq := EthRPCRequest{}
+    calldata := "0x" + erc20BalanceOf + strings.Repeat("0", 24) + strings.ToLower(req.Address[2:])
+    rpcReq.constructCallRequest(CallArgs{To: token, Data: calldata}, blockParam)
+
+    var returnData string
+    err = callRPC(ctx, svc.client, rpcReq, &returnData)
+    if err != nil {
+        return nil, err
+    }
+
+    if returnData == "0x" {
+        return nil, ErrEmptyCallResult
+    }
+    if !isHexData(returnData, 32) {
+        return nil, ErrDecodingABI
+    }
+    balance, _ := decodeHexBig(returnData)
+
+    metadata := svc.tokenMetadata(ctx, []string{token})[token]
+
+    return TokenBalance{
+        Token: token,
+        Address: req.Address,
+        Block: blockLabel,
+        Balance: balance.String(),
+        DisplayBalance: formatTokenAmount(balance, metadata.Decimals),
+        TokenMetadata: metadata,
+    }, nil
+}
+
+// withTokenMovements decodes the token movements of txs from their
+// calldata and of receipts from their logs, then renders the amounts of
+// all of them with one metadata lookup.
+func (svc EthServiceImp) withTokenMovements(ctx context.Context, txs []Transaction, receipts []*Receipt) {
+    movements := [][]TokenMovement{}
+    for i := range txs {
+        txs[i].TokenMovements = decodeTokenCalldata(txs[i])
+        movements = append(movements, txs[i].TokenMovements)
+    }
+    for _, receipt := range receipts {
+        receipt.TokenMovements = decodeTokenLogs(receipt.Logs)
+        movements = append(movements, receipt.TokenMovements)
+    }
+
+    tokens := []string{}
+    for _, group := range movements {
+        for _, movement := range group {
+            tokens = append(tokens, movement.Token)
+        }
+    }
+    if len(tokens) == 0 {
+        return
+    }
+
+    metadata := svc.tokenMetadata(ctx, tokens)
+    for _, group := range movements {
+        for i := range group {
+            token := metadata[group[i].Token]
+            amount, _ := new(big.Int).SetString(group[i].Amount, 10)
+
+            group[i].Symbol = token.Symbol
+            group[i].Decimals = token.Decimals
+            group[i].DisplayAmount = formatTokenAmount(amount, token.Decimals)
+        }
+    }
+}
+
+// tokenMetadata returns the metadata of tokens, asking the node in one
+// batch for those not cached yet. A failed lookup leaves the tokens without
+// metadata rather than failing the request that needed it, and is not
+// cached.
+func (svc EthServiceImp) tokenMetadata(ctx context.Context, tokens []string) map[string]TokenMetadata {
+    metadata := map[string]TokenMetadata{}
+    missing := []string{}
+    for _, token := range tokens {
+        if _, ok := metadata[token]; ok {
+            continue
+        }
+
+        cached, ok := svc.Tokens.get(token)
+        metadata[token] = cached
+        if !ok {
+            missing = append(missing, token)
+        }
+    }
+    if len(missing) == 0 {
+        return metadata
+    }
+
+    selectors := []string{erc20Name, erc20Symbol, erc20Decimals}
+    rpcReqs := make([]EthRPCRequest, len(missing) * len(selectors))
+    for i, token := range missing {
+        for j, selector := range selectors {
+            rpcReqs[i * len(selectors) + j].constructCallRequest(CallArgs{To: token, Data: "0x" + selector}, "latest")
+        }
+    }
+
+    resps, err := svc.client.CallBatch(ctx, rpcReqs)
+    if err != nil {
+        logUpstream(ctx, "Token metadata lookup failed: " + err.Error())
+        return metadata
+    }
+
+    for i, token := range missing {
+        results := make([][]byte, len(selectors))
+        complete := true
+        for j := range selectors {
+            results[j], err = decodeCallResult(resps[i * len(selectors) + j])
+            if err != nil {
+                logUpstream(ctx, "Token metadata lookup for " + token + " failed: " + err.Error())
+                complete = false
+            }
+        }
+
+        tokenMetadata := TokenMetadata{
+            Name: decodeTokenString(results[0]),
+            Symbol: decodeTokenString(results[1]),
+            Decimals: decodeTokenDecimals(results[2]),
+        }
+        metadata[token] = tokenMetadata
+        if complete {
+            svc.Tokens.put(token, tokenMetadata)
+        }
+    }
+
+    return metadata
+}
+
+// decodeCallResult returns the data of an eth_call response. A revert is
+// an answer, the function is not there, so it comes back as no data.
+func decodeCallResult(resp []byte) ([]byte, error) {
+    var returnData string
+    err := decodeRPCResult(resp, &returnData)
+    if rpcErr, ok := err.(*RPCError); ok && (rpcErr.Code == RPCErrExecutionReverted || strings.Contains(rpcErr.Message, "execution reverted")) {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    data, err := hex.DecodeString(strings.TrimPrefix(returnData, "0x"))
+    if err != nil {
+        return nil, ErrParsingJSON
+    }
+    return data, nil
+}
+
+/* ----- DECODING ----- */
+
+// decodeTokenCalldata decodes a call of transfer, transferFrom or approve.
+// Other calls, and calls with malformed arguments, move no tokens.
+func decodeTokenCalldata(tx Transaction) []TokenMovement {
+    input, err := hex.DecodeString(strings.TrimPrefix(tx.Input, "0x"))
+    if err != nil || len(input) < 4 || tx.To == "" {
+        return nil
+    }
+
+    selector, args := hex.EncodeToString(input[:4]), input[4:]
+    movement := TokenMovement{Source: TokenSourceCalldata, Token: strings.ToLower(tx.To), From: strings.ToLower(tx.From)}
+
+    var ok bool
+    switch selector {
+    case erc20Transfer, erc20Approve:
+        movement.Kind = TokenTransfer
+        if selector == erc20Approve {
+            movement.Kind = TokenApproval
+        }
+        movement.To, ok = decodeAddressWord(args, 0)
+        movement.Amount, ok = decodeAmountWord(args, 1, ok)
+    case erc20TransferFrom:
+        movement.Kind = TokenTransfer
+        movement.Spender = movement.From
+        movement.From, ok = decodeAddressWord(args, 0)
+        if ok {
+            movement.To, ok = decodeAddressWord(args, 1)
+        }
+        movement.Amount, ok = decodeAmountWord(args, 2, ok)
+    }
+
+    if !ok {
+        return nil
+    }
+    return []TokenMovement{movement}
+}
+
+// decodeTokenLogs decodes the Transfer and Approval events in logs.
+// ERC-721 transfers, which index the token id, do not fit and are skipped.
+func decodeTokenLogs(logs []Log) []TokenMovement {
+    movements := []TokenMovement{}
+    for _, log := range logs {
+        if log.Removed || len(log.Topics) != 3 {
+            continue
+        }
+
+        kind := ""
+        switch strings.ToLower(log.Topics[0]) {
+        case TransferEventTopic:
+            kind = TokenTransfer
+        case ApprovalEventTopic:
+            kind = TokenApproval
+        default:
+            continue
+        }
+
+        from, fromOk := decodeAddressTopic(log.Topics[1])
+        to, toOk := decodeAddressTopic(log.Topics[2])
+        data, err := hex.DecodeString(strings.TrimPrefix(log.Data, "0x"))
+        if !fromOk || !toOk || err != nil || len(data) != 32 {
+            continue
+        }
+
+        movements = append(movements, TokenMovement{
+            Kind: kind,
+            Source: TokenSourceLog,
+            Token: strings.ToLower(log.Address),
+            From: from,
+            To: to,
+            Amount: new(big.Int).SetBytes(data).String(),
+            LogIndex: log.LogIndex,
+        })
+    }
+
+    if len(movements) == 0 {
+        return nil
+    }
+    return movements
+}
+
+// decodeAddressWord reads the i-th 32 byte word of args as an address,
+// which must be zero padded.
+func decodeAddressWord(args []byte, i int) (string, bool) {
+    if len(args) < 32 * (i + 1) {
+        return "", false
+    }
+
+    word := args[32 * i:32 * (i + 1)]
+    for _, b := range word[:12] {
+        if b != 0 {
+            return "", false
+        }
+    }
+    return "0x" + hex.EncodeToString(word[12:]), true
+}
+
+// decodeAmountWord reads the i-th 32 byte word of args as a decimal
+// amount, when the words before it were fine.
+func decodeAmountWord(args []byte, i int, ok bool) (string, bool) {
+    if !ok || len(args) < 32 * (i + 1) {
+        return "", false
+    }
+    return new(big.Int).SetBytes(args[32 * i:32 * (i + 1)]).String(), true
+}
+
+func decodeAddressTopic(topic string) (string, bool) {
+    word, err := hex.DecodeString(strings.TrimPrefix(topic, "0x"))
+    if err != nil || len(word) != 32 {
+        return "", false
+    }
+    return decodeAddressWord(word, 0)
+}
+
+// decodeTokenString decodes the name or symbol of a token, an ABI string
+// or, for early tokens such as MKR, a zero padded bytes32.
+func decodeTokenString(data []byte) string {
+    if len(data) == 32 {
+        text := strings.TrimRight(string(data), "\x00")
+        if utf8.ValidString(text) && !strings.ContainsRune(text, 0) {
+            return text
+        }
+        return ""
+    }
+
+    text, _ := decodeABIString(data)
+    return text
+}
+
+// decodeTokenDecimals decodes the uint8 returned by decimals().
+func decodeTokenDecimals(data []byte) *int {
+    if len(data) != 32 {
+        return nil
+    }
+
+    decimals := new(big.Int).SetBytes(data)
+    if decimals.BitLen() > 8 {
+        return nil
+    }
+
+    n := int(decimals.Int64())
+    return &n
+}
+
+// formatTokenAmount renders amount in whole tokens, without trailing
+// zeros, e.g. 1500000 with 6 decimals as "1.5". It is empty when decimals
+// are unknown.
+func formatTokenAmount(amount *big.Int, decimals *int) string {
+    if amount == nil || decimals == nil {
+        return ""
+    }
+
+    digits := amount.String()
+    if *decimals == 0 {
+        return digits
+    }
+    if len(digits) <= *decimals {
+        digits = strings.Repeat("0", *decimals - len(digits) + 1) + digits
+    }
+
+    whole := digits[:len(digits) - *decimals]
+    fraction := strings.TrimRight(digits[len(digits) - *decimals:], "0")
+    if fraction == "" {
+        return whole
+    }
+    return whole + "." + fraction
+}
\ No newline at end of file
@@ -0,0 +1,355 @@
+package router
+
+import (
+    "bufio"
+    "context"
+    "crypto/subtle"
+    "encoding/hex"
+    "math/big"
+    "net/http"
+    "os"
+    "strings"
+
+    "google.golang.org/grpc/metadata"
+)
+
+const DebugTokenHeader string = "X-Debug-Token"
+const debugTokenMetadataKey string = "x-debug-token"
+
+// DefaultMaxConcurrentTraces is how many traces may run on the node at once.
+const DefaultMaxConcurrentTraces int = 2
+
+// Tracers of debug_traceTransaction.
+const (
+    callTracer string = "callTracer"
+    prestateTracer string = "prestateTracer"
+)
+
+type debugTokenContextKey struct{}
+
+/* ----- ACCESS ----- */
+
+// DebugAccess gates the debug endpoints, which replay transactions and are
+// expensive for the node. Callers present one of Tokens in the
+// X-Debug-Token header, or x-debug-token metadata over gRPC, and at most
+// MaxConcurrent calls run at a time. Without tokens the endpoints are off.
+type DebugAccess struct {
+    Tokens []string
+
+    slots chan struct{}
+}
+
+func NewDebugAccess(tokens []string, maxConcurrent int) *DebugAccess {
+    if maxConcurrent < 1 {
+        maxConcurrent = 1
+    }
+    return &DebugAccess{Tokens: tokens, slots: make(chan struct{}, maxConcurrent)}
+}
+
+// LoadDebugTokens reads one token per line from path, skipping blank lines
+// and # comments.
+func LoadDebugTokens(path string) ([]string, error) {
+    file, err := os.Open(path)
+    if err != nil {
+        return nil, err
+    }
+    defer file.Close()
+
+    tokens := []string{}
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        token := strings.TrimSpace(scanner.Text())
+        if token == "" || strings.HasPrefix(token, "#") {
+            continue
+        }
+        tokens = append(tokens, token)
+    }
+    if err = scanner.Err(); err != nil {
+        return nil, err
+    }
+
+    if len(tokens) == 0 {
+        return nil, ErrNoDebugTokens
+    }
+    return tokens, nil
+}
+
+// authorize checks the debug token of ctx.
+func (access *DebugAccess) authorize(ctx context.Context) error {
+    if access == nil || len(access.Tokens) == 0 {
+        return ErrDebugDisabled
+    }
+
+    token := DebugTokenFromContext(ctx)
+    if token == "" {
+        return ErrMissingDebugToken
+    }
+
+    allowed := false
+    for _, candidate := range access.Tokens {
+        if subtle.ConstantTimeCompare([]byte(token), []byte(candidate)) == 1 {
+            allowed = true
+        }
+    }
+    if !allowed {
+        return ErrInvalidDebugToken
+    }
+    return nil
+}
+
+// acquire checks the debug token of ctx and takes a slot, failing rather
+// than queueing when all of them are taken. release gives the slot back.
+func (access *DebugAccess) acquire(ctx context.Context) (func(), error) {
+    if err := access.authorize(ctx); err != nil {
+        return nil, err
+    }
+
+    select {
+    case access.slots <- struct{}{}:
+        return func() { <-access.slots }, nil
+    default:
+        return nil, ErrTooManyTraces
+    }
+}
+
+func WithDebugToken(ctx context.Context, token string) context.Context {
+    return context.WithValue(ctx, debugTokenContextKey{}, token)
+}
+
+func DebugTokenFromContext(ctx context.Context) string {
+    token, _ := ctx.Value(debugTokenContextKey{}).(string)
+    return token
+}
+
+// debugTokenFromHTTP is a go-kit ServerBefore hook reading the
+// X-Debug-Token header.
+func debugTokenFromHTTP(ctx context.Context, r *http.Request) context.Context {
+    if token := r.Header.Get(DebugTokenHeader); token != "" {
+        return WithDebugToken(ctx, token)
+    }
+    return ctx
+}
+
+// debugTokenFromGRPC is a go-kit ServerBefore hook reading x-debug-token
+// metadata.
+func debugTokenFromGRPC(ctx context.Context, md metadata.MD) context.Context {
+    if values := md.Get(debugTokenMetadataKey); len(values) > 0 {
+        return WithDebugToken(ctx, values[0])
+    }
+    return ctx
+}
+
+/* ----- TRACES ----- */
+
+// TraceTransactionRequest asks for the call tree of a mined transaction,
+// and for the state it ran against when Prestate is set.
+type TraceTransactionRequest struct {
+    TransactionHash string
+    Prestate bool
+}
+
+// CallFrame is a call made while executing a transaction, with the calls
+// it made in turn. Types are CALL, STATICCALL, DELEGATECALL, CALLCODE,
+// CREATE, CREATE2 and SELFDESTRUCT. Error is set when the frame failed,
+// and RevertReason when it reverted with a Solidity error or panic.
+type CallFrame struct {
+    Type string `json:"type"`
+    From string `json:"from"`
+    To string `json:"to,omitempty"`
+    Value string `json:"value"`
+    Gas string `json:"gas"`
+    GasUsed string `json:"gasUsed"`
+    Input string `json:"input"`
+    Output string `json:"output,omitempty"`
+    Error string `json:"error,omitempty"`
+    RevertReason string `json:"revertReason,omitempty"`
+    Calls []CallFrame `json:"calls,omitempty"`
+}
+
+// ValueTransfer is ether moved by a call inside a transaction.
+// TraceAddress is the path of child indexes to its frame from the top one.
+type ValueTransfer struct {
+    Type string `json:"type"`
+    From string `json:"from"`
+    To string `json:"to"`
+    Value string `json:"value"`
+    TraceAddress []int `json:"traceAddress"`
+}
+
+// PrestateAccount is an account touched by a transaction as it was before
+// the transaction ran.
+type PrestateAccount struct {
+    Balance string `json:"balance"`
+    Nonce uint64 `json:"nonce,omitempty"`
+    Code string `json:"code,omitempty"`
+    Storage map[string]string `json:"storage,omitempty"`
+}
+
+// TransactionTrace is the call tree of a transaction with the internal
+// value transfers that took effect. Prestate is keyed by address.
+type TransactionTrace struct {
+    TransactionHash string `json:"transactionHash"`
+    Call CallFrame `json:"call"`
+    Transfers []ValueTransfer `json:"transfers"`
+    Prestate map[string]PrestateAccount `json:"prestate,omitempty"`
+}
+
+type traceConfig struct {
+    Tracer string `json:"tracer"`
+}
+
+// Solidity panic codes of Panic(uint256).
+var panicReasons = map[int64]string{
+    0x00: "generic panic",
+    0x01: "assertion failed",
+    0x11: "arithmetic overflow or underflow",
+    0x12: "division or modulo by zero",
+    0x21: "invalid enum value",
+    0x22: "invalid storage byte array",
+    0x31: "pop on empty array",
+    0x32: "array index out of bounds",
+    0x41: "out of memory",
+    0x51: "call to zero-initialized function",
+}
+
+var (
+    errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+    panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// TraceTransaction replays a transaction with geth's callTracer, and with
+// its prestateTracer in the same batch when asked to. The caller needs a
+// debug token.
+func (svc EthServiceImp) TraceTransaction(ctx context.Context, req TraceTransactionRequest) (interface{}, error) {
+    if !isHexHash(req.TransactionHash) {
+        return nil, ErrInvalidTransactionHash
+    }
+
+    release, err := svc.Debug.acquire(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer release()
+
+    trace := TransactionTrace{TransactionHash: req.TransactionHash}
+
+    rpcReqs := []EthRPCRequest{{}}
+    rpcReqs[0].constructTraceTransactionRequest(req.TransactionHash, callTracer)
+    results := []interface{}{&trace.Call}
+    if req.Prestate {
+        rpcReqs = append(rpcReqs, EthRPCRequest{})
+        rpcReqs[1].constructTraceTransactionRequest(req.TransactionHash, prestateTracer)
+        results = append(results, &trace.Prestate)
+    }
+
+    if len(rpcReqs) == 1 {
+        err = callRPC(ctx, svc.client, rpcReqs[0], results[0])
+    } else {
+        err = callRPCBatch(ctx, svc.client, rpcReqs, results)
+    }
+    if isTransactionNotFound(err) {
+        return nil, ErrNullResult
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    normalizeCallFrame(&trace.Call)
+    trace.Transfers = collectValueTransfers(trace.Call, []int{}, []ValueTransfer{})
+
+    if trace.Prestate != nil {
+        prestate := make(map[string]PrestateAccount, len(trace.Prestate))
+        for address, account := range trace.Prestate {
+            prestate[strings.ToLower(address)] = account
+        }
+        trace.Prestate = prestate
+    }
+
+    return trace, nil
+}
+
+// normalizeCallFrame upper cases types, lower cases addresses, fills in
+// the zero value of calls without one and decodes revert reasons the node
+// left out.
+func normalizeCallFrame(frame *CallFrame) {
+    frame.Type = strings.ToUpper(frame.Type)
+    frame.From = strings.ToLower(frame.From)
+    frame.To = strings.ToLower(frame.To)
+    if frame.Value == "" {
+        frame.Value = "0x0"
+    }
+    if frame.Input == "" {
+        frame.Input = "0x"
+    }
+    if frame.Error != "" && frame.RevertReason == "" {
+        frame.RevertReason = decodeRevertReason(frame.Output)
+    }
+
+    for i := range frame.Calls {
+        normalizeCallFrame(&frame.Calls[i])
+    }
+}
+
+// collectValueTransfers appends the ether moved below frame, found at
+// traceAddress, to transfers. Failed frames move nothing, neither do their
+// children. DELEGATECALL and CALLCODE run in the caller's context and
+// STATICCALL cannot carry value. The top frame is the transaction itself,
+// whose value is not an internal transfer.
+func collectValueTransfers(frame CallFrame, traceAddress []int, transfers []ValueTransfer) []ValueTransfer {
+    if frame.Error != "" {
+        return transfers
+    }
+
+    if len(traceAddress) > 0 && frame.Type != "DELEGATECALL" && frame.Type != "CALLCODE" && frame.Type != "STATICCALL" {
+        value, ok := decodeHexBig(frame.Value)
+        if ok && value.Sign() > 0 {
+            transfers = append(transfers, ValueTransfer{
+                Type: frame.Type,
+                From: frame.From,
+                To: frame.To,
+                Value: frame.Value,
+                TraceAddress: traceAddress,
+            })
+        }
+    }
+
+    for i, call := range frame.Calls {
+        childAddress := append(append([]int{}, traceAddress...), i)
+        transfers = collectValueTransfers(call, childAddress, transfers)
+    }
+    return transfers
+}
+
+// decodeRevertReason reads the message of an Error(string) revert, or
+// names the code of a Panic(uint256). Other output has no reason.
+func decodeRevertReason(output string) string {
+    data, err := hex.DecodeString(strings.TrimPrefix(output, "0x"))
+    if err != nil || len(data) < 4 {
+        return ""
+    }
+
+    selector, args := data[:4], data[4:]
+    switch {
+    case string(selector) == string(errorSelector):
+        reason, _ := decodeABIString(args)
+        return reason
+    case string(selector) == string(panicSelector) && len(args) == 32:
+        code := new(big.Int).SetBytes(args)
+        if reason, ok := panicReasons[code.Int64()]; code.IsInt64() && ok {
+            return "panic: " + reason + " (" + encodeHexBig(code) + ")"
+        }
+        return "panic: " + encodeHexBig(code)
+    }
+    return ""
+}
+
+// isTransactionNotFound tells whether the node refused a trace because it
+// does not know the transaction.
+func isTransactionNotFound(err error) bool {
+    rpcErr, ok := err.(*RPCError)
+    if !ok {
+        return false
+    }
+
+    return strings.Contains(strings.ToLower(rpcErr.Message), "transaction not found")
+}
\ No newline at end of file
@@ -0,0 +1,46 @@
+package router
+
+import (
+    "context"
+    "net/http"
+    "time"
+
+    "github.com/gorilla/websocket"
+)
+
+/* ----- WEBSOCKET TRANSPORT ----- */
+type wsConn struct {
+    conn *websocket.Conn
+}
+
+func (c wsConn) ReadMessage() ([]byte, error) {
+    _, msg, err := c.conn.ReadMessage()
+    return msg, err
+}
+
+func (c wsConn) WriteMessage(msg []byte) error {
+    c.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+    return c.conn.WriteMessage(websocket.TextMessage, msg)
+}
+
+func (c wsConn) Close() error {
+    return c.conn.Close()
+}
+
+// NewWSRPCClient returns a client for a ws:// or wss:// upstream. auth may be
+// nil; otherwise its headers are sent with every handshake, reconnects
+// included.
+func NewWSRPCClient(url string, auth *Authenticator) *StreamRPCClient {
+    return newStreamRPCClient(url, func(ctx context.Context) (streamConn, error) {
+        var header http.Header
+        if auth != nil {
+            header = auth.Header()
+        }
+
+        conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+        if err != nil {
+            return nil, err
+        }
+        return wsConn{conn}, nil
+    })
+}